@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/negapedia/negapedia/internal/failures"
+	"github.com/negapedia/negapedia/internal/watchdog"
+	"github.com/pkg/errors"
+)
+
+//runID identifies the run in the logs, so that concurrent runs can be told apart.
+var runID = func() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}()
+
+//currentStage is the stage of the run that is reported by every log record.
+var currentStage atomic.Value
+
+//progress is the watchdog whose stage counters are reported by every log record, nil if there is none.
+var progress *watchdog.Watchdog
+
+//stageTimings records when each stage started, for the run report.
+var stageTimings struct {
+	sync.Mutex
+	stages []stageTiming
+}
+
+type stageTiming struct {
+	Stage    string
+	Start    time.Time
+	Duration time.Duration
+}
+
+func setStage(stage string) {
+	currentStage.Store(stage)
+
+	stageTimings.Lock()
+	defer stageTimings.Unlock()
+	now := time.Now()
+	if n := len(stageTimings.stages); n > 0 {
+		stageTimings.stages[n-1].Duration = now.Sub(stageTimings.stages[n-1].Start)
+	}
+	stageTimings.stages = append(stageTimings.stages, stageTiming{Stage: stage, Start: now})
+}
+
+//stagesUntilNow returns the timings of the stages, the current one lasting until now.
+func stagesUntilNow() []stageTiming {
+	stageTimings.Lock()
+	defer stageTimings.Unlock()
+	stages := append([]stageTiming{}, stageTimings.stages...)
+	if n := len(stages); n > 0 {
+		stages[n-1].Duration = time.Since(stages[n-1].Start)
+	}
+	return stages
+}
+
+//setupLogging makes the default logger, also for the log package, write records in the given format (text or json),
+//each one carrying run ID, lang, current stage, time elapsed since start and the progress counters of the stages.
+func setupLogging(format string, start time.Time) error {
+	var handler slog.Handler
+	switch format {
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, nil)
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, nil)
+	default:
+		return errors.New("error: log format " + format + " not supported")
+	}
+	slog.SetDefault(slog.New(stageHandler{handler, start}).With("run", runID, "lang", lang))
+	return nil
+}
+
+type stageHandler struct {
+	slog.Handler
+	start time.Time
+}
+
+func (h stageHandler) Handle(ctx context.Context, r slog.Record) error {
+	stage, _ := currentStage.Load().(string)
+	r.AddAttrs(slog.String("stage", stage), slog.Duration("elapsed", time.Since(h.start)))
+	if states := progress.States(); len(states) > 0 {
+		counts := make([]any, len(states))
+		for i, s := range states {
+			counts[i] = slog.Int64(s.Stage, s.Count)
+		}
+		r.AddAttrs(slog.Group("progress", counts...))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h stageHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return stageHandler{h.Handler.WithAttrs(attrs), h.start}
+}
+
+func (h stageHandler) WithGroup(name string) slog.Handler {
+	return stageHandler{h.Handler.WithGroup(name), h.start}
+}
+
+//fatal logs every failure, or err if there are none, and exits.
+func fatal(collector *failures.Collector, err error) {
+	ff := collector.Failures()
+	if len(ff) == 0 {
+		slog.Error("Run failed", "error", fmt.Sprintf("%+v", err))
+		os.Exit(1)
+	}
+	for i, f := range ff {
+		slog.Error("Failure", "n", i+1, "component", f.Component, "time", f.Time, "error", f.Err.Error(), "stack", f.Stack)
+	}
+	slog.Error("Run failed, the first failure caused the termination", "failures", len(ff))
+	os.Exit(1)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	"github.com/negapedia/negapedia/internal/preprocessor"
+	"github.com/negapedia/wikibrief"
+	"github.com/negapedia/wikitfidf"
+)
+
+//Preprocessing processes that depend on packages other than preprocessor are registered here.
+func init() {
+	preprocessor.Register(preprocessor.Definition{
+		Name: "tfidf",
+		Outputs: func(env preprocessor.Environment) []preprocessor.Output {
+			return []preprocessor.Output{{Path: "TFIDF", Savepoint: true}}
+		},
+		New: func(env preprocessor.Environment) (preprocessor.Process, error) {
+			if err := wikitfidf.CheckAvailableLanguage(env.Lang); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, fail func(error) error, articles <-chan wikibrief.EvolvingPage) {
+				var err error
+				if tfidf, err = wikitfidf.New(ctx, env.Lang, articles, ".", wikitfidf.ReasonableLimits(), env.Test); err != nil {
+					fail(err)
+				}
+			}, nil
+		},
+	})
+}
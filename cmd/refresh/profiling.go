@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	_ "net/http/pprof" //profiling endpoints
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+)
+
+const (
+	profilesDir        = "profiles"
+	heapSnapshotPeriod = 10 * time.Minute
+)
+
+//startProfiling serves the net/http/pprof endpoints and periodically snapshots the heap, if profiling is enabled.
+func startProfiling(ctx context.Context) {
+	if !profile {
+		return
+	}
+
+	if err := os.MkdirAll(profilesDir, os.ModePerm); err != nil {
+		log.Print("While creating profiles folder encountered the following error ", err)
+	}
+
+	go func() {
+		log.Print("Serving pprof endpoints on ", pprofAddress)
+		log.Print("While serving pprof endpoints encountered the following error ", http.ListenAndServe(pprofAddress, nil))
+	}()
+
+	go func() {
+		ticker := time.NewTicker(heapSnapshotPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case t := <-ticker.C:
+				writeHeapSnapshot(t.Format("2006-01-02 15:04:05"))
+			}
+		}
+	}()
+}
+
+//profileStage captures the CPU profile of stage until the returned function is called, if profiling is enabled.
+func profileStage(stage string) (stop func()) {
+	if !profile {
+		return func() {}
+	}
+
+	filename := filepath.Join(profilesDir, fmt.Sprint("cpu ", stage, ".pprof"))
+	f, err := os.Create(filename)
+	if err == nil {
+		err = pprof.StartCPUProfile(f)
+	}
+	if err != nil {
+		log.Print("While starting CPU profile of ", stage, " encountered the following error ", err)
+		if f != nil { //do not leave an empty profile behind
+			f.Close()
+			os.Remove(filename)
+		}
+		return func() {}
+	}
+
+	return func() {
+		pprof.StopCPUProfile()
+		if err := f.Close(); err != nil {
+			log.Print("While writing CPU profile of ", stage, " encountered the following error ", err)
+		}
+		writeHeapSnapshot("after " + stage)
+	}
+}
+
+func writeHeapSnapshot(name string) {
+	filename := filepath.Join(profilesDir, fmt.Sprint("heap ", name, ".pprof"))
+	f, err := os.Create(filename)
+	if err == nil {
+		err = pprof.WriteHeapProfile(f)
+		if e := f.Close(); err == nil {
+			err = e
+		}
+	}
+	if err != nil {
+		log.Print("While writing heap snapshot ", name, " encountered the following error ", err)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log/slog"
+	"os"
+
+	"github.com/negapedia/negapedia/internal/exporter"
+	"github.com/pkg/errors"
+)
+
+//validate runs the data quality checks and writes their report in quality.json, so that it's available even if the run is aborted.
+func validate(ctx context.Context, m exporter.Exporter, checks map[string]float64) (r exporter.QualityReport, err error) {
+	if r, err = m.Validate(ctx, checks); err != nil {
+		return
+	}
+
+	report, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return r, errors.Wrap(err, "Error while marshalling quality report")
+	}
+	if err = ioutil.WriteFile("quality.json", report, os.ModePerm); err != nil {
+		return r, errors.Wrap(err, "Error while writing quality report")
+	}
+
+	for _, c := range r.Failed() {
+		slog.Warn("Data quality check failed", "check", c.Name, "description", c.Description, "faulty", c.Faulty, "total", c.Total, "threshold", c.Threshold)
+	}
+	if !r.Passed && failOnQuality {
+		return r, errors.New("error: data quality checks failed, see quality.json")
+	}
+	return
+}
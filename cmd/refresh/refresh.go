@@ -6,25 +6,29 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
+	"log/slog"
 	"net/url"
 	"os"
 	"os/signal"
 	"path"
 	"runtime"
+	"runtime/pprof"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
-	"github.com/ebonetti/ctxutils"
-
 	"github.com/jmoiron/sqlx"
 	"github.com/negapedia/negapedia/internal/exporter"
+	"github.com/negapedia/negapedia/internal/failures"
 	"github.com/negapedia/negapedia/internal/preprocessor"
+	"github.com/negapedia/negapedia/internal/synthetic"
+	"github.com/negapedia/negapedia/internal/watchdog"
 	"github.com/negapedia/wikiassignment/nationalization"
 	"github.com/negapedia/wikibrief"
 	"github.com/negapedia/wikitfidf"
@@ -34,83 +38,217 @@ import (
 var lang, dataSource, baseURL, dbopts string
 var keepSavepoints bool
 var calculateTFIDF, test bool
+var errorBudget int
+var stallTimeout time.Duration
+var failOnStall bool
+var profile bool
+var pprofAddress string
+var logFormat string
+var qualityChecks string
+var failOnQuality bool
+var syntheticSize int
+var syntheticSeed int64
+var cachePath string
+var processes string
+var windowFrom, windowTo string
+var granularity string
+var settings = preprocessor.DefaultSettings
+var botPolicy string
+var thresholds exporter.Thresholds
 
 func init() {
 	flag.StringVar(&lang, "lang", "it", "Wikipedia nationalization to parse.")
-	flag.StringVar(&dataSource, "source", "net", "Source of data (net,savepoint).")
+	flag.StringVar(&dataSource, "source", "net", "Source of data (net,synthetic,replay,savepoint).")
 	flag.StringVar(&baseURL, "url", "http://%s.negapedia.org", "Output base URL, '%s' is the optional placeholder for subdomain.")
 	flag.StringVar(&dbopts, "db", "user=postgres dbname=postgres sslmode=disable", "Options for connecting to the db.")
 	flag.BoolVar(&keepSavepoints, "keep", false, "Keep every savepoint after the execution (true or false).")
-	flag.BoolVar(&calculateTFIDF, "tfidf", false, "Calculate TFIDF, if false, try available precalculated measures (true or false).")
+	flag.BoolVar(&calculateTFIDF, "tfidf", false, "Calculate TFIDF, if false, try available precalculated measures (true or false), shorthand for adding tfidf to process.")
 	flag.BoolVar(&test, "test", false, "Run as test on a fraction of the articles before savepoint (true or false).")
+	flag.IntVar(&errorBudget, "errbudget", 0, "Number of failing pages that are skipped and reported in quarantine.json before aborting the export.")
+	flag.DurationVar(&stallTimeout, "stall", 0, "Time without progress in the pipelines after which diagnostics are dumped, 0 disables stall detection.")
+	flag.BoolVar(&failOnStall, "stallfail", false, "Fail the run upon a stall (true or false).")
+	flag.BoolVar(&profile, "profile", false, "Serve pprof endpoints, periodically snapshot the heap and capture CPU profiles of every stage in the profiles folder (true or false).")
+	flag.StringVar(&pprofAddress, "pprof", "localhost:6060", "Address of the pprof endpoints, used if profile is true.")
+	flag.StringVar(&logFormat, "log", "text", "Format of the logs (text or json).")
+	flag.StringVar(&qualityChecks, "checks", "", "Comma separated data quality checks thresholds overriding the default ones, as name=threshold or name=off.")
+	flag.BoolVar(&failOnQuality, "checksfail", false, "Abort the run if a data quality check fails (true or false).")
+	flag.IntVar(&syntheticSize, "synthsize", 10000, "Number of articles of the synthetic wiki, used if source is synthetic.")
+	flag.Int64Var(&syntheticSeed, "synthseed", 1, "Seed of the synthetic wiki, used if source is synthetic.")
+	flag.StringVar(&windowFrom, "from", "", "First day of the analysis window (YYYY-MM-DD), empty for the beginning of the history.")
+	flag.StringVar(&windowTo, "to", "", "Last day of the analysis window (YYYY-MM-DD), empty for the end of the history.")
+	flag.StringVar(&granularity, "granularity", "year", "Granularity of the index time series (year, quarter or month), finer ones are added to the yearly ones.")
+	flag.DurationVar(&settings.EditWarWindow, "editwarwindow", settings.EditWarWindow, "Time within which two users reverting each other are in an edit war.")
+	flag.IntVar(&settings.NewcomerRevertWindow, "newcomerwindow", settings.NewcomerRevertWindow, "Number of following revisions within which the first edit of a user to an article counts as reverted.")
+	flag.StringVar(&botPolicy, "bots", "exclude", "Treatment of bots in social jumps, indices and statistics (include, exclude or separate), the default exclude drops bots from popularity, conflict and polemic, which counted them in older versions.")
+	flag.IntVar(&thresholds.PolemicMinUsers, "polemicminusers", 0, "Minimum number of editors of an article in a year for it to be polemic.")
+	flag.IntVar(&thresholds.PolemicMinRevisions, "polemicminrevisions", 0, "Minimum number of revisions of an article in a year for it to be polemic.")
+	flag.Float64Var(&thresholds.PolemicPrior, "polemicprior", 0, "Number of editors at which the confidence in article polemic is 0.5, 0 for full confidence.")
+	flag.IntVar(&thresholds.ShareMinActivity, "shareminactivity", 10, "Minimum number of revisions of an article in a year for its anonymous and bot shares, and of first edits for its newcomerrevert share.")
+	flag.StringVar(&processes, "process", "csv", "Comma separated preprocessing processes to run ("+strings.Join(preprocessor.Registered(), ",")+"), csv is needed by the export.")
+	flag.StringVar(&cachePath, "cache", "", "Replay cache of the preprocessed articles: recorded by net and synthetic sources, replayed by replay source; empty disables recording.")
 }
 
 func main() {
 	stackTraceOn(syscall.SIGUSR1) //enable logging to a file the current stack trace upon receiving the signal SIGUSR1
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		render(os.Args[2:])
+		return
+	}
+	start := time.Now()
 	flag.Parse()
-	log.Println("Called with the command: ", strings.Join(os.Args, " "))
-	log.Printf("Interpreted as: refresh -lang = %s -url = %s -source = %s -db = '%s' -keep = %t -tfidf = %t -test = %t\n", lang, baseURL, dataSource, dbopts, keepSavepoints, calculateTFIDF, test)
+	ctx, collector := failures.WithCollector(context.Background())
+	fail := collector.Fail("refresh")
+
+	setStage("setup")
+	if err := setupLogging(logFormat, start); err != nil {
+		fatal(collector, fail(err))
+	}
+	slog.Info("Called with the command", "command", strings.Join(os.Args, " "))
+	flagsAttrs := []any{}
+	flag.VisitAll(func(f *flag.Flag) {
+		flagsAttrs = append(flagsAttrs, f.Name, f.Value.String())
+	})
+	slog.Info("Interpreted as refresh", flagsAttrs...)
+	report := newRunReport(start)
 
-	start := time.Now()
 	defer func() {
-		log.Println("Time elapsed since start: ", time.Since(start))
+		slog.Info("Time elapsed since start", "total", time.Since(start))
 	}()
 
-	ctx, fail := ctxutils.WithFail(context.Background())
+	if stallTimeout < 0 || (stallTimeout > 0 && stallTimeout < watchdog.MinTimeout) {
+		fatal(collector, fail(errors.Errorf("error: stall must be 0 or at least %v", watchdog.MinTimeout)))
+	}
+	ctx, progress = watchdog.New(ctx)
+	if stallTimeout > 0 {
+		progress.Watch(ctx, collector.Fail("watchdog"), stallTimeout, ".", failOnStall)
+	}
+
+	startProfiling(ctx)
 
 	_, err := nationalization.New(lang)
 	if err != nil {
-		log.Fatalf("%+v", fail(err))
+		fatal(collector, fail(err))
+	}
+
+	checks, err := exporter.ParseChecks(qualityChecks)
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	window, err := getWindow()
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	if err = exporter.Granularity(granularity).Check(); err != nil {
+		fatal(collector, fail(err))
+	}
+
+	if err = exporter.BotPolicy(botPolicy).Check(); err != nil {
+		fatal(collector, fail(err))
+	}
+	settings.IncludeBots = exporter.BotPolicy(botPolicy) == exporter.IncludeBots
+	botsSet := false
+	flag.Visit(func(f *flag.Flag) { botsSet = botsSet || f.Name == "bots" })
+	if !botsSet {
+		slog.Warn("Bots are excluded from popularity, conflict and polemic, which counted them in older versions, so rankings differ from theirs: set bots to silence this warning", "bots", botPolicy)
+	}
+
+	if err = thresholds.Check(); err != nil {
+		fatal(collector, fail(err))
 	}
 
 	const csvDir = "csv"
+	names := processNames()
+	outputs, err := preprocessor.Outputs(csvDir, names...)
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+	if !hasProcess(names, "csv") {
+		fatal(collector, fail(errors.New("error: process csv is needed by the export")))
+	}
+
 	err = os.MkdirAll(csvDir, 777)
 	if err != nil {
-		log.Fatalf("%+v", fail(err))
+		fatal(collector, fail(err))
 	}
 
-	if dataSource == "net" {
-		log.Print("Started data preprocessing")
-		preprocess(ctx, fail, csvDir, lang, test)
+	if source, ok := sources[dataSource]; ok {
+		if cachePath != "" && dataSource != "replay" {
+			texts, _ := preprocessor.NeedsTexts(names...) //names have been already checked
+			source = preprocessor.Recorded(source, cachePath, texts)
+		}
+		setStage("preprocess")
+		slog.Info("Started data preprocessing")
+		stopProfile := profileStage("preprocess")
+		preprocess(ctx, fail, csvDir, lang, test, source, names)
+		stopProfile()
 		if ctx.Err() != nil {
-			log.Fatalf("%+v", fail(nil))
+			fatal(collector, fail(nil))
 		}
 	} else if dataSource != "savepoint" {
-		log.Fatalf("%+v", fail(errors.New("error: datasource "+dataSource+" not supported")))
+		fatal(collector, fail(errors.New("error: datasource "+dataSource+" not supported")))
+	} else if err = preprocessor.CheckSavepoint(csvDir, settings, names...); err != nil {
+		fatal(collector, fail(err))
 	}
 
 	if tfidf.Lang == "" { //TFIDF data is optional
-		log.Print("No TFIDF data found")
+		slog.Info("No TFIDF data found")
 	}
 
-	log.Print("Started savepoint data import")
+	setStage("import")
+	slog.Info("Started savepoint data import")
 
 	db, err := getDB()
 	if err != nil {
-		log.Fatalf("%+v", fail(err))
+		fatal(collector, fail(err))
 	}
 
 	wwwURL, langURL, err := getURLs()
 	if err != nil {
-		log.Fatalf("%+v", fail(err))
+		fatal(collector, fail(err))
 	}
 
-	m, dbDestructor, err := exporter.From(ctx, db, lang, csvDir, wwwURL, langURL, TFIDFExporter(ctx, fail, tfidf)...)
+	stopProfile := profileStage("import")
+	m, dbDestructor, err := exporter.From(ctx, db, lang, csvDir, window, exporter.Granularity(granularity), exporter.BotPolicy(botPolicy), thresholds, wwwURL, langURL, TFIDFExporter(ctx, collector.Fail("tfidf"), tfidf)...)
+	stopProfile()
 	if err != nil {
-		log.Fatalf("%+v", fail(err))
+		fatal(collector, fail(err))
 	}
 
 	if ctx.Err() != nil {
-		log.Fatalf("%+v", fail(nil))
+		fatal(collector, fail(nil))
+	}
+
+	setStage("validate")
+	if report.Quality, err = validate(ctx, m, checks); err != nil {
+		fatal(collector, fail(err))
 	}
 
-	if !keepSavepoints {
-		defer func() {
+	report.TFIDF = tfidf.Lang != ""
+	if report.Data, err = m.DataStats(ctx); err != nil {
+		fatal(collector, fail(err))
+	}
+	report.SocialJumpsCoverage = report.Data.SocialJumpsCoverage()
+	if report.Sinks["csv"], err = dirSink(csvDir); err != nil {
+		fatal(collector, fail(err))
+	}
+
+	quarantine := exporter.NewQuarantine(errorBudget)
+	m = m.WithQuarantine(quarantine)
+
+	defer func() {
+		for _, o := range outputs {
+			if !o.Savepoint || !keepSavepoints {
+				os.RemoveAll(o.Path)
+			}
+		}
+		if !keepSavepoints {
 			os.RemoveAll(csvDir)
 			tfidf.Delete()
 			dbDestructor()
-		}()
-	}
+		}
+	}()
 
 	var tarball *tar.Writer
 	{
@@ -130,61 +268,156 @@ func main() {
 		defer tarball.Close()
 	}
 
-	log.Print("Started tarball dump")
-
-	var b bytes.Buffer
-
-	for vfile := range m.Everything(ctx, fail) {
-		b.Reset()
-		b.Write([]byte(vfile.Data))
-		compressor, _ := gzip.NewWriterLevel(&b, gzip.BestCompression)
-		if _, err = io.CopyN(compressor, &b, int64(b.Len())); err != nil {
+	setStage("export")
+	slog.Info("Started tarball dump")
+
+	stopProfile = profileStage("export and dump") //goroutines are labeled with their stage
+	var vfiles <-chan exporter.VFile
+	pprof.Do(ctx, pprof.Labels("stage", "export"), func(ctx context.Context) {
+		vfiles = m.Everything(ctx, collector.Fail("exporter"))
+	})
+	var files <-chan vFile
+	pprof.Do(ctx, pprof.Labels("stage", "dump"), func(ctx context.Context) {
+		files = compress(ctx, collector.Fail("compress"), vfiles, &report.Exported)
+	})
+
+	html := &sinkStats{}
+	report.Sinks["html"] = html
+	progress := watchdog.Progress(ctx, "dump")
+	for file := range files {
+		progress.Inc()
+		html.add(file.Size())
+		header, err := tar.FileInfoHeader(file, "")
+		if err != nil {
 			fail(err)
 			break
 		}
-		if err = compressor.Close(); err != nil {
+		if err = tarball.WriteHeader(header); err != nil {
 			fail(err)
 			break
 		}
-
-		header, err := tar.FileInfoHeader(newVFile(path.Join("html", vfile.Path+".gz"), b.Bytes()), "")
+		_, err = tarball.Write(file.Data())
 		if err != nil {
 			fail(err)
 			break
 		}
-		if err = tarball.WriteHeader(header); err != nil {
+	}
+
+	progress.Done()
+	stopProfile()
+
+	if ctx.Err() == nil {
+		report.Exported.Quarantined = int64(len(quarantine.Faults))
+		if report.Sinks["quarantine"], err = writeQuarantine(tarball, quarantine); err != nil {
 			fail(err)
-			break
 		}
-		_, err = tarball.Write(b.Bytes())
-		if err != nil {
+	}
+
+	if ctx.Err() == nil {
+		if err = writeReport(tarball, report); err != nil {
 			fail(err)
-			break
 		}
 	}
 
 	if err = fail(nil); err != nil {
-		log.Fatalf("%+v", err)
+		fatal(collector, err)
 	}
-	log.Print("Tarball dump exported successfully")
+	slog.Info("Tarball dump exported successfully", "files", html.Files, "bytes", html.Bytes)
+}
+
+//compress renders and gzips in parallel the virtual files, it's the only place where whole (compressed) files are buffered.
+func compress(ctx context.Context, fail func(error) error, in <-chan exporter.VFile, exported *exportedStats) <-chan vFile {
+	out := make(chan vFile, runtime.NumCPU())
+	go func() {
+		defer close(out)
+		var wg sync.WaitGroup
+		for i := 0; i < runtime.NumCPU(); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				compressor, _ := gzip.NewWriterLevel(nil, gzip.BestCompression)
+				for vfile := range in {
+					var b bytes.Buffer
+					compressor.Reset(&b)
+					n, err := vfile.WriteTo(compressor)
+					switch {
+					case err == exporter.ErrQuarantined:
+						continue
+					case err != nil:
+						fail(err)
+						return
+					}
+					if err := compressor.Close(); err != nil {
+						fail(err)
+						return
+					}
+					exported.add(vfile, n)
+
+					select {
+					case out <- newVFile(path.Join("html", vfile.Path+".gz"), b.Bytes()):
+						//Go on
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}()
+	return out
+}
+
+//writeQuarantine adds to the tarball the report of the skipped pages.
+func writeQuarantine(tarball *tar.Writer, quarantine *exporter.Quarantine) (*sinkStats, error) {
+	report, err := json.MarshalIndent(quarantine, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while marshalling quarantine report")
+	}
+	if len(quarantine.Faults) > 0 {
+		slog.Warn("Skipped pages, see quarantine.json", "skipped", len(quarantine.Faults))
+	}
+
+	if err = writeTarFile(tarball, "quarantine.json", report); err != nil {
+		return nil, err
+	}
+	return &sinkStats{1, int64(len(report))}, nil
 }
 
 func getDB() (db *sqlx.DB, err error) {
 	for t := time.Second; t < 5*time.Minute; t *= 2 { //exponential backoff
 		db, err = sqlx.Connect("postgres", dbopts)
 		if err == nil {
-			log.Print("Connected to the database")
+			slog.Info("Connected to the database")
 			return
 		}
 		err = errors.Wrap(err, "Unable to connect to the database")
 		if t > 30*time.Second {
-			log.Print(err.Error())
+			slog.Warn(err.Error())
 		}
 		time.Sleep(t)
 	}
 	return
 }
 
+//getWindow returns the analysis window specified by the from and to options, the last day is included.
+func getWindow() (window exporter.Window, err error) {
+	if windowFrom != "" {
+		if window.From, err = time.Parse("2006-01-02", windowFrom); err != nil {
+			return window, errors.Wrap(err, "Invalid from date")
+		}
+	}
+	if windowTo != "" {
+		if window.To, err = time.Parse("2006-01-02", windowTo); err != nil {
+			return window, errors.Wrap(err, "Invalid to date")
+		}
+		window.To = window.To.AddDate(0, 0, 1)
+	}
+	if !window.From.IsZero() && !window.To.IsZero() && !window.From.Before(window.To) {
+		return window, errors.New("error: from date must not follow to date")
+	}
+	return
+}
+
 func getURLs() (wwwURL, langURL url.URL, err error) {
 	switch strings.Count(baseURL, "%s") {
 	case 0:
@@ -218,24 +451,44 @@ func init() {
 	tfidf, _ = wikitfidf.From(lang, "TFIDF")
 }
 
-func preprocess(ctx context.Context, fail func(error) error, CSVDir, lang string, test bool) {
-	process := []preprocessor.Process{}
-	if calculateTFIDF && wikitfidf.CheckAvailableLanguage(lang) == nil {
-		process = append(process, func(ctx context.Context, fail func(error) error, articles <-chan wikibrief.EvolvingPage) {
-			var tfidfErr error
-			tfidf, tfidfErr = wikitfidf.New(ctx, lang, articles, ".", wikitfidf.ReasonableLimits(), test)
-			if tfidfErr != nil {
-				fail(tfidfErr)
-			}
-		})
+//sources maps the data sources that need preprocessing to their implementation.
+var sources = map[string]preprocessor.Source{
+	"net": preprocessor.NetSource,
+	"synthetic": func(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage {
+		return synthetic.New(ctx, fail, lang, synthetic.DefaultConfig(syntheticSize, syntheticSeed))
+	},
+	"replay": func(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage {
+		texts, _ := preprocessor.NeedsTexts(processNames()...) //names have been already checked
+		return preprocessor.Replay(cachePath, texts)(ctx, fail, tmpDir, lang, test)
+	},
+}
+
+//processNames returns the preprocessing processes selected by the process and tfidf options.
+func processNames() []string {
+	names := strings.Split(processes, ",")
+	if calculateTFIDF && wikitfidf.CheckAvailableLanguage(lang) == nil && !hasProcess(names, "tfidf") {
+		names = append(names, "tfidf")
+	}
+	return names
+}
+
+func hasProcess(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
 	}
-	if err := preprocessor.Run(ctx, CSVDir, lang, test, process...); err != nil {
+	return false
+}
+
+func preprocess(ctx context.Context, fail func(error) error, CSVDir, lang string, test bool, source preprocessor.Source, names []string) {
+	if err := preprocessor.Run(ctx, CSVDir, lang, test, settings, source, names...); err != nil {
 		fail(err)
 	}
 }
 
 func stackTraceOn(sig ...os.Signal) {
-	sigChan := make(chan os.Signal)
+	sigChan := make(chan os.Signal, 1)
 	go func() {
 		stacktrace := make([]byte, 8388608)
 		for range sigChan {
@@ -243,8 +496,7 @@ func stackTraceOn(sig ...os.Signal) {
 			filename := fmt.Sprint("stacktrace ", time.Now().Format("2006-01-02 15:04:05"))
 			err := ioutil.WriteFile(filename, stacktrace, os.ModePerm)
 			if err != nil {
-				log.Print("While writing stack trace on file encountered the following error ", err)
-				log.Print("Stack trace follows: ", string(stacktrace))
+				slog.Error("While writing stack trace on file encountered an error", "error", err.Error(), "stacktrace", string(stacktrace))
 			}
 		}
 	}()
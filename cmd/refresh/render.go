@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/negapedia/negapedia/internal/exporter"
+	"github.com/negapedia/negapedia/internal/failures"
+	"github.com/negapedia/wikitfidf"
+	"github.com/pkg/errors"
+)
+
+//render prints to stdout a single page, taken from an already imported database: useful for debugging templates and rankings.
+func render(args []string) {
+	var title string
+	var ID int64
+	var asJSON bool
+	flags := flag.NewFlagSet("render", flag.ExitOnError)
+	flags.StringVar(&lang, "lang", lang, "Wikipedia nationalization of the imported data.")
+	flags.StringVar(&baseURL, "url", baseURL, "Output base URL, '%s' is the optional placeholder for subdomain.")
+	flags.StringVar(&dbopts, "db", dbopts, "Options for connecting to the db.")
+	flags.StringVar(&title, "title", "", "Title of the page to render.")
+	flags.Int64Var(&ID, "id", -1, "ID of the page to render, alternative to title (0 is the homepage).")
+	flags.BoolVar(&asJSON, "json", false, "Print the raw page Info as JSON instead of HTML (true or false).")
+	flags.StringVar(&logFormat, "log", "text", "Format of the logs (text or json).")
+	start := time.Now()
+	flags.Parse(args)
+
+	ctx, collector := failures.WithCollector(context.Background())
+	fail := collector.Fail("render")
+
+	setStage("render")
+	if err := setupLogging(logFormat, start); err != nil {
+		fatal(collector, fail(err))
+	}
+
+	if (title == "") == (ID < 0) {
+		fatal(collector, fail(errors.New("error: exactly one between -title and -id must be specified")))
+	}
+
+	db, err := getDB()
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	wwwURL, langURL, err := getURLs()
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	tfidf, _ := wikitfidf.From(lang, "TFIDF") //TFIDF data is optional
+	m, _, err := exporter.Open(ctx, db, lang, wwwURL, langURL, TFIDFExporter(ctx, collector.Fail("tfidf"), tfidf)...)
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	if title != "" {
+		pageID, err := m.PageID(ctx, title)
+		if err != nil {
+			fatal(collector, fail(err))
+		}
+		ID = int64(pageID)
+	}
+
+	i, err := m.Page(ctx, collector.Fail("exporter"), uint32(ID))
+	if err != nil {
+		fatal(collector, fail(err))
+	}
+
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = errors.Wrap(encoder.Encode(i), "Error while encoding Info")
+	} else {
+		err = m.Render(os.Stdout, i)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	if err = fail(nil); err != nil {
+		fatal(collector, err)
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/negapedia/negapedia/internal/exporter"
+	"github.com/pkg/errors"
+)
+
+//runReport summarizes a run, it's compared across releases to spot broken dumps.
+type runReport struct {
+	Run, Lang string
+	BotPolicy exporter.BotPolicy
+	Start     time.Time
+	Duration  time.Duration
+	Stages    []stageTiming
+	Data      exporter.DataStats
+	Quality   exporter.QualityReport
+	//SocialJumpsCoverage is the fraction of articles that have social jumps
+	SocialJumpsCoverage float64
+	TFIDF               bool
+	Exported            exportedStats
+	Sinks               map[string]*sinkStats
+}
+
+//exportedStats counts the exported files, it's updated concurrently.
+type exportedStats struct {
+	Pages, TopTens, Quarantined int64
+	//RenderedBytes is the size of the exported files before compression
+	RenderedBytes int64
+}
+
+func (s *exportedStats) add(vfile exporter.VFile, renderedBytes int64) {
+	switch vfile.Kind {
+	case exporter.PageFile:
+		atomic.AddInt64(&s.Pages, 1)
+	case exporter.TopTenFile:
+		atomic.AddInt64(&s.TopTens, 1)
+	}
+	atomic.AddInt64(&s.RenderedBytes, renderedBytes)
+}
+
+//sinkStats measures the data written to a destination of the run.
+type sinkStats struct {
+	Files, Bytes int64
+}
+
+func (s *sinkStats) add(bytes int64) {
+	s.Files++
+	s.Bytes += bytes
+}
+
+func newRunReport(start time.Time) *runReport {
+	return &runReport{Run: runID, Lang: lang, BotPolicy: exporter.BotPolicy(botPolicy), Start: start, Sinks: map[string]*sinkStats{}}
+}
+
+//dirSink returns the files and bytes contained in dir, which may not exist.
+func dirSink(dir string) (s *sinkStats, err error) {
+	s = &sinkStats{}
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		switch {
+		case os.IsNotExist(err):
+			return filepath.SkipDir
+		case err != nil:
+			return err
+		case !info.IsDir():
+			s.add(info.Size())
+		}
+		return nil
+	})
+	return s, errors.Wrap(err, "Error while measuring "+dir)
+}
+
+//String returns the human readable summary of the report.
+func (r *runReport) String() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Run %s of %s, started at %s, lasted %v\n", r.Run, r.Lang, r.Start.Format(time.RFC3339), r.Duration.Round(time.Second))
+	for _, s := range r.Stages {
+		fmt.Fprintf(&b, "  stage %-12s %v\n", s.Stage, s.Duration.Round(time.Second))
+	}
+	d := r.Data
+	fmt.Fprintf(&b, "Articles: %d, topics: %d, social jumps coverage: %.1f%%\n", d.Articles, d.Topics, 100*r.SocialJumpsCoverage)
+	fmt.Fprintf(&b, "Revisions: %d, by bots: %d, anonymous: %d\n", d.Revisions, d.BotRevisions, d.AnonymousRevisions)
+	fmt.Fprintf(&b, "Users: %d, bots: %d, bot policy: %s\n", d.Users, d.Bots, r.BotPolicy)
+	fmt.Fprintf(&b, "Data quality checks passed: %t\n", r.Quality.Passed)
+	for _, c := range r.Quality.Failed() {
+		fmt.Fprintf(&b, "  failed %s: %d over %d (%.2f%% > %.2f%%)\n", c.Name, c.Faulty, c.Total, 100*c.Fraction, 100*c.Threshold)
+	}
+	fmt.Fprintf(&b, "TFIDF available: %t\n", r.TFIDF)
+	e := r.Exported
+	fmt.Fprintf(&b, "Exported pages: %d, top tens: %d, quarantined: %d, rendered bytes: %d\n", e.Pages, e.TopTens, e.Quarantined, e.RenderedBytes)
+	for _, name := range []string{"csv", "html", "quarantine"} {
+		if s, ok := r.Sinks[name]; ok {
+			fmt.Fprintf(&b, "Sink %-10s files: %d, bytes: %d\n", name, s.Files, s.Bytes)
+		}
+	}
+	return b.String()
+}
+
+//writeReport completes the report and adds it to the tarball, both as JSON and as human readable summary.
+func writeReport(tarball *tar.Writer, r *runReport) error {
+	r.Stages = stagesUntilNow()
+	r.Duration = time.Since(r.Start)
+
+	report, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "Error while marshalling run report")
+	}
+	if err = writeTarFile(tarball, "report.json", report); err != nil {
+		return err
+	}
+
+	summary := r.String()
+	slog.Info("Run report\n" + summary)
+	return writeTarFile(tarball, "report.txt", []byte(summary))
+}
+
+func writeTarFile(tarball *tar.Writer, name string, data []byte) error {
+	header, err := tar.FileInfoHeader(newVFile(name, data), "")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err = tarball.WriteHeader(header); err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = tarball.Write(data)
+	return errors.WithStack(err)
+}
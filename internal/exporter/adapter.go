@@ -1,7 +1,14 @@
 package exporter
 
 import (
+	"bufio"
 	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
 
 	"github.com/pkg/errors"
 )
@@ -73,3 +80,147 @@ func externalDataAdapter(ctx context.Context, fail func(error) error, extDataCha
 		}
 	}
 }
+
+//chunkExtData is the external data of a chunk of page IDs coming from one of the external data channels, spilled to a file.
+type chunkExtData struct {
+	ready chan struct{} //closed when path is complete or the spill failed
+	path  string        //empty if the chunk has no external data
+}
+
+//spillExtData spills each one of extDataChannels to a file for each chunk of page IDs in dir, so that chunks can be loaded in any order
+//while the channels are consumed at their own pace. It returns, for each channel and chunk, where to find its external data.
+//Files are gob streams, so that field values keep their types: these are registered with gob as they are met.
+func spillExtData(ctx context.Context, fail func(error) error, extDataChannels []<-chan ExtData, chunks int, dir string) (channel2Chunks [][]*chunkExtData) {
+	for c, in := range extDataChannels {
+		chunk2Data := make([]*chunkExtData, chunks)
+		for chunk := range chunk2Data {
+			chunk2Data[chunk] = &chunkExtData{ready: make(chan struct{})}
+		}
+		channel2Chunks = append(channel2Chunks, chunk2Data)
+
+		go func(c int, in <-chan ExtData) {
+			chunk := 0
+			defer func() {
+				for ; chunk < chunks; chunk++ {
+					close(chunk2Data[chunk].ready)
+				}
+			}()
+
+			var f *os.File
+			var b *bufio.Writer
+			var encoder *gob.Encoder
+			registered := map[reflect.Type]bool{}
+			closeFile := func() (err error) {
+				if f == nil {
+					return
+				}
+				if err = b.Flush(); err == nil {
+					err = f.Close()
+				} else {
+					f.Close()
+				}
+				f = nil
+				return errors.Wrap(err, "Error while spilling external data")
+			}
+			defer closeFile()
+
+			oldData := ExtData{}
+			for {
+				var data ExtData
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case data, ok = <-in:
+					//Go on
+				}
+
+				switch {
+				case !ok:
+					if err := closeFile(); err != nil {
+						fail(err)
+					}
+					return
+				case oldData.ID > data.ID:
+					fail(errors.Errorf("Channel should be ordered in increasing order by ID, but %v > %v", oldData, data))
+					return
+				case int(data.ID/_PagesChunkSize) >= chunks:
+					continue //Discard data of absent pages
+				}
+				oldData = data
+
+				for ; chunk < int(data.ID/_PagesChunkSize); chunk++ { //Chunks before data are complete
+					if err := closeFile(); err != nil {
+						fail(err)
+						return
+					}
+					close(chunk2Data[chunk].ready)
+				}
+				if f == nil {
+					path := filepath.Join(dir, fmt.Sprintf("%v.%v.gob", c, chunk))
+					var err error
+					if f, err = os.Create(path); err != nil {
+						fail(errors.Wrap(err, "Error while spilling external data"))
+						return
+					}
+					chunk2Data[chunk].path = path
+					b = bufio.NewWriter(f)
+					encoder = gob.NewEncoder(b)
+				}
+				for _, v := range data.Fields {
+					if t := reflect.TypeOf(v); t != nil && !registered[t] {
+						gob.Register(v)
+						registered[t] = true
+					}
+				}
+				if err := encoder.Encode(data); err != nil {
+					fail(errors.Wrap(err, "Error while spilling external data"))
+					return
+				}
+			}
+		}(c, in)
+	}
+	return
+}
+
+//load waits for the external data of the chunk and yields it, it fails if the spill of the chunk failed.
+func (d *chunkExtData) load(ctx context.Context, fail func(error) error) <-chan ExtData {
+	out := make(chan ExtData, 1)
+	go func() {
+		defer close(out)
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.ready:
+			//Go on
+		}
+		if d.path == "" {
+			return
+		}
+
+		f, err := os.Open(d.path)
+		if err != nil {
+			fail(errors.Wrap(err, "Error while loading external data"))
+			return
+		}
+		defer f.Close()
+		decoder := gob.NewDecoder(bufio.NewReader(f))
+		for {
+			var data ExtData
+			switch err = decoder.Decode(&data); {
+			case err == io.EOF:
+				return
+			case err != nil:
+				fail(errors.Wrap(err, "Error while loading external data"))
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- data:
+				//Go on
+			}
+		}
+	}()
+	return out
+}
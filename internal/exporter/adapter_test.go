@@ -0,0 +1,100 @@
+package exporter
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+//tfidfFields returns external fields with the types of the ones coming from wikitfidf
+func tfidfFields(ID uint32) map[string]interface{} {
+	return map[string]interface{}{
+		"Word2Occur":  map[string]uint32{"word": ID},
+		"Word2TFIDF":  map[string]float64{"word": float64(ID) / 3},
+		"BWord2Occur": map[string]int{"badword": int(ID)},
+	}
+}
+
+func TestSpillExtData(t *testing.T) {
+	tests := []struct {
+		name   string
+		ids    []uint32
+		chunks int
+		want   [][]uint32 //IDs by chunk
+	}{
+		{"empty", nil, 2, [][]uint32{nil, nil}},
+		{"one chunk", []uint32{0, 1, _PagesChunkSize - 1}, 1, [][]uint32{{0, 1, _PagesChunkSize - 1}}},
+		{"chunk boundaries", []uint32{1, _PagesChunkSize, _PagesChunkSize + 1, 2 * _PagesChunkSize}, 3, [][]uint32{{1}, {_PagesChunkSize, _PagesChunkSize + 1}, {2 * _PagesChunkSize}}},
+		{"empty chunks", []uint32{2 * _PagesChunkSize}, 3, [][]uint32{nil, nil, {2 * _PagesChunkSize}}},
+		{"absent pages", []uint32{3, _PagesChunkSize, 5 * _PagesChunkSize}, 1, [][]uint32{{3}}},
+		{"equal IDs", []uint32{7, 7}, 1, [][]uint32{{7, 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var mutex sync.Mutex
+			var failure error
+			fail := func(err error) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				if failure == nil {
+					failure = err
+				}
+				cancel()
+				return err
+			}
+
+			in := make(chan ExtData, len(tt.ids))
+			for _, id := range tt.ids {
+				in <- ExtData{id, tfidfFields(id)}
+			}
+			close(in)
+
+			chunksExtData := spillExtData(ctx, fail, []<-chan ExtData{in}, tt.chunks, t.TempDir())[0]
+			got := make([][]uint32, tt.chunks)
+			for chunk := tt.chunks - 1; chunk >= 0; chunk-- { //chunks must not wait for the previous ones
+				for data := range chunksExtData[chunk].load(ctx, fail) {
+					if !reflect.DeepEqual(data.Fields, tfidfFields(data.ID)) {
+						t.Errorf("chunk %v: fields of %v are %v", chunk, data.ID, data.Fields)
+					}
+					got[chunk] = append(got[chunk], data.ID)
+				}
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if failure != nil {
+				t.Fatal(failure)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpillExtDataUnordered(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	failures := make(chan error, 2)
+	fail := func(err error) error {
+		failures <- err
+		cancel()
+		return err
+	}
+
+	in := make(chan ExtData, 2)
+	in <- ExtData{ID: 2}
+	in <- ExtData{ID: 1}
+	close(in)
+
+	for range spillExtData(ctx, fail, []<-chan ExtData{in}, 1, t.TempDir())[0][0].load(ctx, fail) {
+	}
+	select {
+	case <-failures:
+	case <-time.After(time.Second):
+		t.Error("unordered external data has been accepted")
+	}
+}
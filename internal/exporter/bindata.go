@@ -2,8 +2,11 @@
 // sources:
 // db/base.sql
 // db/indices.sql
+// db/quality.sql
+// db/query-cohorttoptenbyyear.sql
 // db/query-pages.sql
 // db/query-toptenbyyear.sql
+// db/stats.sql
 // db/test.sql
 // db/types.sql
 // templates/data.html
@@ -90,54 +93,87 @@ func (fi bindataFileInfo) Sys() interface{} {
 }
 
 var _bindataDbBasesql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x6f\x6f\xa3\xb8\x13\x7e\xcf\xa7\x98\x7d\x15\x52\xf1\xeb\x3f\xe9" +
-	"\x27\x9d\x36\xca\x0b\x2f\x71\x5a\xee\x08\x44\x40\xba\xcd\x9e\x56\x91\x1b\xdc\xd4\xbb\x04\x10\x38\xed\x46\xa7\xfb" +
-	"\xee\x27\x1b\x30\x26\xd0\x54\x77\x7d\xd5\xd8\x33\xe3\x67\xe6\x99\x79\x6c\x66\x81\xbf\x84\xd0\xbe\xc7\x0b\x04\xce" +
-	"\x1c\xf0\xa3\x13\x46\x21\xbc\xdd\x66\x60\xa3\xd0\x46\x33\x3c\x31\xec\x00\xa3\x08\x37\x46\x6f\xb7\xd9\xc4\x30\xae" +
-	"\x2e\x16\x47\x96\xc6\xf4\x17\x14\x34\x2f\x68\x49\x53\x5e\x42\x4e\x76\x14\xf8\x31\xa7\x16\x30\x0e\x5b\x92\xc2\x13" +
-	"\x85\x5d\x92\x3d\x91\xc4\x02\x9e\xe5\x6c\x0b\x59\x01\xa4\xe0\x6c\x9b\xd0\x8b\xab\x26\x70\xb4\x5e\x62\x11\xf6\x72" +
-	"\x7f\x14\x11\x44\x00\x40\x21\x60\x6f\xb5\x00\x73\x54\xf9\x8f\x2c\x18\xc9\x08\xe2\x9f\x3a\xc2\x68\x3c\x31\x9a\x18" +
-	"\xb6\xef\xba\x28\x72\x7c\xaf\x0e\xb4\xcd\x92\x84\x70\x0a\xa6\xeb\xdb\xc8\xc5\x30\x85\x11\x4d\x37\xab\xf0\x72\x15" +
-	"\xcd\xff\xf7\x9b\xf4\xbc\xba\x58\x92\x1d\x2d\xf5\x04\xde\xd8\x4f\x96\xd3\x98\x91\x06\x64\x09\x24\x8d\x21\x7b\xa5" +
-	"\x45\xb5\x2c\x21\x94\x1a\x74\xf4\xc5\xad\xb0\xe7\x32\x96\x69\x00\x80\xac\xc3\x86\xc5\xa0\xfd\x39\x5e\x84\xef\x70" +
-	"\x00\x9e\x1f\x81\xb7\x72\x5d\xab\x35\xe4\x8c\x27\x54\x19\x3e\xa0\xc0\xbe\x47\x81\xf9\xff\x9b\xdb\x71\x9d\x15\xee" +
-	"\xe6\xa4\xb9\x92\xa7\x92\x17\x64\xcb\x2b\xd7\x08\x3f\x46\xe7\x5d\x0a\x9a\xf2\x0e\xb0\x33\xb0\xca\x6c\xcb\x48\xf2" +
-	"\xe3\xb0\xcf\xcb\xd6\xf0\xcf\xef\xca\x14\x66\x78\x8e\x56\x6e\x04\xa3\xbf\xfe\x1e\xe9\xe9\x08\xfa\xd4\xdf\x09\xad" +
-	"\x7d\xe7\x86\xcb\xcf\x9f\xbb\xa6\x5a\xc4\x6d\x41\x09\x67\x59\x7a\xa4\xa4\x50\x48\x8c\x8a\xc2\x80\xbe\xb2\x92\x65" +
-	"\xe9\x79\x1a\x81\xc6\x8c\x97\x30\xc4\x5b\xa1\x02\xfc\x4b\xee\x0a\xfa\xba\x29\x69\xc1\x48\xa2\xac\x87\x0d\x0f\x25" +
-	"\x2d\x86\x23\xea\xfb\xe5\x53\xc6\xd5\xfe\x17\xdf\x77\x31\xf2\x06\x4e\xdc\xbe\x90\xe2\x8d\xb2\xdd\x4b\x65\x3c\x77" +
-	"\x7d\x14\xbd\x63\x16\xb3\xe7\xe7\x3a\xde\x7b\x66\xac\x2c\xe8\x2b\x2d\xf8\x87\x89\x36\x86\x34\xfe\x00\x1f\x67\x7b" +
-	"\x5a\x72\xb2\xcf\xeb\x96\x74\x16\x38\x8c\xd0\x62\x39\x60\x5a\xd1\x79\x5a\x93\x9a\xd7\x50\xeb\x3e\x56\x02\x01\x4e" +
-	"\xf7\x79\x56\x90\xe2\x08\x9c\x3c\x25\x54\x54\x2d\x86\xe7\xac\x80\x24\x23\x31\x4b\x77\xa0\xf5\xab\x05\xa2\xe9\x0b" +
-	"\x88\x09\x27\xc2\x7b\x4f\x8b\x1d\x8d\x81\xa5\x3c\x83\x6a\x4e\x65\x8c\xa1\x76\xd0\xbb\xfe\xbf\x0c\xf3\xf0\xd4\xdc" +
-	"\x5c\x7f\x97\x79\x19\x57\x17\x6e\x46\xe2\x0a\x98\x50\x96\x98\x3e\xb3\x94\xd6\x29\x49\x3d\xa5\x42\x5d\x8c\xab\x8b" +
-	"\xd9\x61\xbf\x3f\x56\x8a\xaa\x52\xad\xa4\x10\x4a\x4e\x38\x2b\x79\x25\x44\x8e\x17\xe2\x20\x12\x27\xf9\xad\x0e\x99" +
-	"\x35\x68\xab\x9d\x79\xab\x9d\xce\x31\x3c\x20\x77\x85\x43\x30\xaf\x2d\xb8\xb6\xa0\x91\xd8\xd3\x11\x94\xf2\xea\x2f" +
-	"\xd7\x03\x71\x5b\xe1\xb2\x3a\x42\x64\xa9\xf3\xc6\x30\x0f\xfc\x05\x7c\x1e\x49\xc7\x67\x96\xd0\x9c\xf0\x97\x11\x7c" +
-	"\x75\xa2\x7b\xb0\xc3\x07\xb8\xc7\x68\x86\x83\x49\x7b\x82\x9a\x44\x75\x8a\x3e\x62\x56\x3d\x46\x56\x3b\x2e\x56\x77" +
-	"\x20\x2c\xbd\xf1\xad\x4e\xdb\x5a\x27\x4d\x6c\x75\x7b\x55\x61\x55\x10\xce\xe0\x35\x90\x1b\xe1\xe0\x54\xf9\x65\x07" +
-	"\xa0\xd9\x0c\x96\x81\xb3\x40\xc1\x1a\xfe\xc0\x6b\x68\x12\x19\x5b\x6a\x7b\xee\x07\xd8\xb9\xf3\x9a\x6d\x55\xac\x00" +
-	"\xcf\x71\x80\x3d\x1b\x87\xfa\x6d\xd2\xf8\x4f\x8c\xd5\x72\xd6\xa8\x7a\xb5\x17\xe2\x48\xd3\xdb\x69\x73\x3b\x9e\x72" +
-	"\x08\x5f\xef\x71\x80\xdb\x36\x98\x5e\x03\xf2\x66\x4d\x53\x7f\x9a\x5e\x4f\x0c\xdb\x5d\x85\x22\xa3\x36\xf6\x2a\x74" +
-	"\xbc\xbb\x6a\x52\x36\xf9\x4f\x7a\x9c\x18\xc8\x43\xee\xfa\x9b\x76\xbe\x7a\x15\x38\xde\x0c\x3f\x42\x7d\xef\xea\xb0" +
-	"\xab\x77\x40\xdb\x28\xa2\x9b\xb4\x2c\x5a\xdd\x15\x99\x28\x3d\x98\x8a\x67\x47\x04\x26\x7e\x8c\x02\x64\x47\xe6\x1a" +
-	"\xa3\xa0\x62\x27\x26\x9c\x6e\x78\x71\x48\xb7\xe6\x48\x98\x8e\x4e\x49\x1c\x8b\x47\x43\x3d\x71\xe3\x49\x8f\x26\x75" +
-	"\xe0\x59\xaa\x3a\x3d\xf7\x3e\x6f\x15\x2d\x1f\xb0\x56\x7b\x4b\x1c\xb6\xef\xae\x16\x5e\x9b\xa7\x48\xba\x91\x8f\x2e" +
-	"\x05\x6d\x61\x2a\x1a\xd4\xef\x01\x2a\xd4\xde\x30\x1d\xda\xdd\x56\x4f\x8f\xf6\x62\x6a\x2b\x23\x4a\xf8\x94\x1d\xd2" +
-	"\xb8\x04\x14\x1a\x21\x76\xb1\x1d\xc1\xc2\xf1\xcc\x06\xad\xac\xec\x9e\xc9\x0b\xd8\x82\x05\x7a\x3c\xd9\x21\xbf\xe4" +
-	"\x8e\xd1\xf8\x68\x83\x55\x39\xaa\x85\xd6\xfb\xc4\x86\xfc\x52\x0b\x86\xa4\xfb\x24\xbd\x56\x26\x74\x85\xad\xc7\x56" +
-	"\x5b\x3a\x33\xb8\x83\x23\x64\x9e\xaa\xb6\xd5\x7b\x72\x8c\x61\x0a\xe6\xe6\xb2\x67\x08\xf5\x5a\xc7\xd6\x80\x0a\x54" +
-	"\x75\x77\x48\x0c\xc2\xa8\xb1\x11\xb9\x56\x5b\x75\x99\x95\x50\xd7\xe5\x15\x06\xbd\xa8\xd2\x41\x55\xa5\xa5\xcb\x3a" +
-	"\xd1\x9f\x66\xd6\x1b\x55\xf8\x34\x7d\xff\x7d\x25\x1d\x56\x9e\x78\x31\x23\xd7\x1d\x84\xd4\x6b\x81\x0f\x80\x75\x27" +
-	"\xec\x2e\xf0\x57\x4b\xf8\xb2\x6e\xe2\x8d\x07\xcf\xb0\x7d\xe4\xe2\xd0\xc6\x66\xf9\xa3\x5f\x61\xf1\xb2\x6c\x0f\xee" +
-	"\xd4\xfe\x0c\x94\x4e\xbd\x5d\x3c\x8f\xe0\x77\xdf\xf1\x7a\xbd\x53\xfe\xa8\x07\xcc\xd4\x00\x8e\x61\x53\x57\xb1\x26" +
-	"\x97\xc5\x30\xad\x94\xb0\xf9\x3d\x31\xe4\x27\xd3\xe0\x93\xa1\xaf\x3a\xda\xe5\xa0\xeb\x40\xff\x5d\xdb\x15\x84\xe6" +
-	"\xeb\x84\x17\x94\xc2\x36\x4b\x39\x61\x69\x09\xfc\x45\xfc\xd8\xe7\x09\xe5\x14\x76\x05\xc9\x5f\x20\x7b\xae\xb5\x1d" +
-	"\x0a\x9a\xc8\x68\xda\xd7\xc9\x02\x45\x38\x70\x90\xeb\x7c\xc3\x33\x78\x70\xf0\x57\x05\x49\xc6\x6d\x87\xbd\xff\x5c" +
-	"\x68\x87\xb0\xca\x40\x76\x8a\x32\xbf\xbd\x6c\x3d\x6e\x2e\xdf\x73\x82\xfc\xa6\x2d\x7d\xbd\x72\x2b\xb4\x49\xfa\x48" +
-	"\xff\xa9\x0c\x55\xbb\xb7\xda\x54\xa9\x58\x03\x74\xb3\x4d\x0e\x25\x17\xfa\x25\x3f\x38\xb5\xbb\x46\xa6\x31\xf0\xd8" +
-	"\x19\xf7\x6f\x35\x69\xda\x5e\x6c\xfd\xb8\xfd\x4b\x4e\xd8\x4c\xfe\x09\x00\x00\xff\xff\xa7\x3a\x3a\xc1\x20\x0f\x00" +
-	"\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x59\x6d\x6f\xdb\x38\x12\xfe\xee\x5f\x31\xbb\x5f\x2c\x17\x4a\x9a\x04" +
+	"\x38\xe0\x90\x5c\x0e\x50\x6d\x25\xd1\x9e\x2c\xa7\x92\xdc\x36\xbb\x58\x18\x8c\x4d\xc7\x6a\x65\xc9\x27\xd1\x75\x8d" +
+	"\xc5\xfe\xf7\x1b\x92\x22\x45\xc9\xb2\xdb\x2e\xb0\x8b\x03\xd6\x08\x02\x5b\x9c\x19\x0e\xe7\xf5\xe1\x68\x14\x4e\x1e" +
+	"\x21\x1a\x3e\xb8\x63\x07\xbc\x3b\x70\x3f\x78\x51\x1c\xc1\xee\x2a\x87\xa1\x13\x0d\x9d\x91\x7b\xd3\x1b\x86\xae\x13" +
+	"\xbb\x8a\x08\x97\x6e\x7a\xbd\xd7\xaf\xc6\xfb\x24\x5b\xd0\x2f\x50\xd0\x4d\x41\x4b\x9a\xb1\x12\x36\xe4\x85\x02\xdb" +
+	"\x6f\xa8\x0d\x09\x83\x39\xc9\xe0\x99\xc2\x4b\x9a\x3f\x93\xd4\x06\x96\x6f\x92\x39\xe4\x05\x90\x82\x25\xf3\x94\xbe" +
+	"\x7a\xad\x04\xc7\x4f\x8f\x2e\x17\x7b\xbe\xde\x73\x09\x5c\x00\x38\x11\xb8\xc1\x74\x0c\x56\x5f\xf2\xf7\x6d\xe8\x0b" +
+	"\x09\xfc\x4b\x25\xa1\x3f\x40\x45\x2a\x19\xc3\x89\xef\x3b\xb1\x37\x09\x2a\x41\xf3\x3c\x4d\x09\xa3\x60\xf9\x93\xa1" +
+	"\xe3\xbb\x70\x0b\x7d\x9a\xcd\xa6\xd1\xf9\x34\xbe\x3b\xfb\xa7\xe0\x7c\xfd\xea\x11\x77\x2b\xcd\x03\xec\x92\x4f\xc9" +
+	"\x86\x2e\x12\xa2\x94\x2c\x81\x64\x0b\xc8\x3f\xd3\x42\x3e\x16\x2a\x94\x86\xea\xce\x1b\x5f\xea\xbe\x11\xb2\xac\x1e" +
+	"\xe0\x87\x7f\x9f\x25\x0b\x30\x3e\x5e\x10\xbb\xf7\x6e\x08\xc1\x24\x86\x60\xea\xfb\x76\x4d\xc8\x12\x96\x52\x4d\xf8" +
+	"\xce\x09\x87\x0f\x4e\x68\xfd\xe3\xf2\x6a\x50\x9d\xca\x6d\x9e\xc9\x60\x25\xcf\x25\x2b\xc8\x9c\x49\xd6\xd8\xfd\x10" +
+	"\x9f\x66\x29\xf0\x94\x0d\xc5\x4e\xa8\x55\xe6\xf3\x84\xa4\x1f\xb7\xeb\x4d\x59\x13\xfe\xf2\xab\x26\x85\x91\x7b\xe7" +
+	"\x4c\xfd\x18\xfa\xbf\xfd\xde\x37\x8f\xc3\xdd\xa7\x3f\x2d\xb7\x1e\x32\x2b\x5f\x5e\x5f\x37\x49\x0d\x89\xf3\x82\x12" +
+	"\x96\xe4\xd9\x9e\x92\x42\x6b\x62\xae\xe7\xab\xbc\x60\xad\x23\xf5\xa4\x8b\x43\xfa\x39\x29\x91\xf7\xb4\x9b\x01\x7f" +
+	"\xb0\xd2\x46\x9a\xcf\xb3\x0d\x2d\x92\x7c\x01\x49\x09\x6c\x45\x21\x25\xcf\x34\x85\x7c\xc9\x7f\x24\x05\x94\xdb\xe7" +
+	"\x33\xa1\x46\x45\x65\xd1\xf3\x97\x73\xb8\xba\xb8\xbc\x38\x7b\x7b\xc9\x83\x5b\x7c\xbd\xb8\x1c\xd8\xf2\x98\xc9\x12" +
+	"\x5e\x0a\x92\x6d\x53\x52\x24\x6c\xcf\x85\x72\xee\xae\xf8\x29\xb4\xa2\xdf\x19\x43\x5c\xe7\x12\xb5\x21\xa9\xa6\xee" +
+	"\x26\xdc\x22\x55\xb7\x44\x73\xbd\x7c\xce\xb5\x29\xe1\xcd\x64\xe2\xbb\x4e\xd0\xb1\xe3\x7c\x45\x8a\x1d\x4d\x5e\x56" +
+	"\x92\xf8\xce\x9f\x38\xf1\x11\xb2\x45\xb2\x5c\x56\xf2\x8e\x91\x25\x25\xfe\xa7\xda\x87\xc7\x0f\xaa\x08\xe9\xe2\x2b" +
+	"\xfa\xb1\x64\x4d\x4b\x46\xd6\x9b\x2a\x35\xbc\xb1\x1b\xc5\xce\xf8\xb1\x83\x54\x86\x55\xb7\x4d\x8c\x80\x50\x1f\x9e" +
+	"\x65\x55\x70\x39\x19\x49\xf7\x65\x52\xee\xb0\x12\xe6\x3b\x24\xc6\x6c\x4c\xe6\x4c\x46\x0e\xa9\x16\xb1\x66\x88\xdf" +
+	"\xb5\x83\x93\x0c\x7e\x91\x2c\xcb\x22\x5f\xdb\x20\xbf\xb3\x1c\x83\x06\x4b\x26\x5d\x6f\x30\x52\x9e\xf3\x6d\x26\x82" +
+	"\x70\x9b\x89\xaf\x74\xd1\x15\x34\xa4\xa9\x80\x13\xf5\x22\xd7\x77\x87\x31\xaf\xdd\xb1\xc5\x8f\xe9\xdd\x59\xd7\xfd" +
+	"\x7a\x33\x5e\x3d\xfb\x03\x5e\x5d\xb5\x45\xc4\x2f\x53\x9d\x2e\x5e\x96\x9f\xe6\x64\xb2\x27\xb8\x98\x46\x3b\x52\x34" +
+	"\x92\x6d\xbd\x65\x5b\x92\x82\xf4\x5b\x89\x1d\x81\xed\x28\xcd\x44\xb4\x61\x90\x27\x05\xa6\x1d\x5b\x11\xc6\xcf\xaa" +
+	"\x68\x30\xdd\x88\x24\x10\x2b\xda\xe5\x95\x19\xf1\x47\x92\xbd\x48\x82\x92\xa7\x7e\x8a\xf6\xa2\xcb\xbc\xa0\x5d\x36" +
+	"\xa2\x4a\xa7\xef\xcc\xab\xe3\xe9\x72\x18\x43\x42\xbd\x59\xcd\x71\x3c\x80\xff\x92\xb8\x0c\xe8\x6e\x9e\xaf\x69\xd3" +
+	"\x0f\xdc\x78\x4b\x34\x37\x93\xc5\x8e\x1b\xb9\xa0\x2f\x49\xc9\x68\x81\xa6\xe5\xba\x8b\x50\x55\x8d\xcf\x16\x9d\x6f" +
+	"\xb7\xa2\xc8\xc7\xfd\x40\xf7\xb0\x43\xca\xda\x19\xca\xf2\x64\x89\x12\xba\x0c\x9f\x69\x2d\xfe\x44\xcb\xff\x9f\x55" +
+	"\x84\xc8\x68\x9a\x18\xd0\x08\x18\x30\x9d\xf3\x82\x14\x7b\x60\xe4\x19\x1b\x0d\x9e\x6d\x01\x18\xaa\x90\xe6\x64\xc1" +
+	"\xa3\xd8\x68\xb3\x36\xf0\x5e\x5d\xc0\x82\x30\xc2\xb9\xd1\x76\x2f\x48\x9d\x64\xe8\x16\x09\x2f\x84\x8c\x2e\x5b\x9b" +
+	"\xcd\xfa\x8f\x60\x90\xee\x66\x7f\x79\xf1\xab\x38\x17\x1e\xcc\x47\x75\xa5\x62\x3c\x2c\x16\x74\x99\x64\xb4\x3a\x92" +
+	"\x80\x81\x94\x83\x22\xa4\x1b\x6d\xd7\xeb\xbd\x04\x82\xfa\xa8\x12\xc1\x01\x1a\x9f\x61\xb8\x49\xfc\xe4\x05\x91\x1b" +
+	"\xc6\x7c\xa7\x49\x0d\x9f\xac\x4a\x69\xbb\x86\x2a\x76\x0d\x2a\x06\x88\x8d\xfc\xa9\x1b\x81\x75\x61\x03\xfe\x29\x64" +
+	"\xd8\x46\x0e\x02\x15\x4e\x1e\x9f\x3a\xe4\xd6\x78\xcb\x6e\xe0\x27\x5b\xef\x37\x80\xbb\x70\x32\x86\xeb\xbe\x60\x5c" +
+	"\x26\x29\xdd\x10\xb6\xea\xc3\x7b\x2f\x7e\x80\x61\xf4\x0e\x1e\x5c\xc4\xc4\xe1\x4d\xbd\x83\xae\xeb\x7a\x17\xb3\x23" +
+	"\xdb\x55\x30\xdb\x75\x77\xb5\x9b\xfd\xd3\x36\xfb\xa4\xdd\x88\x69\xbb\x15\xe1\x76\x33\x90\xb5\xae\x5a\x85\x6f\xd1" +
+	"\x57\xd5\x43\xad\xae\xd2\xb0\x5d\xc7\xec\xee\xcd\x14\xff\xb7\xec\xa5\x4b\x40\xd7\x66\xe6\xc1\xba\xb7\xd2\xec\x27" +
+	"\xf6\xea\x39\x7e\x8c\x31\xdd\x02\xe2\x22\xb2\x9d\xd1\x08\x1e\x43\x6f\xec\x84\x4f\xf0\x1f\xf7\x09\x94\x12\x03\x5b" +
+	"\x2f\xdf\x4d\x42\xd7\xbb\x0f\xd4\xb2\x0e\x82\xd0\xbd\x73\x43\x37\x18\xba\x91\x09\xee\x15\xff\x4d\x6f\xfa\x38\x52" +
+	"\x20\x5b\xae\x45\x6e\x6c\xc0\xdf\x5b\x75\x59\x69\xc7\x26\xbc\x7f\x40\xb9\x75\x78\xdf\x5e\x80\x13\x8c\x54\xb2\xfe" +
+	"\x70\x7b\x81\xc6\xf3\xa7\x11\x3f\x51\x2d\x7b\x1a\x79\xc1\xbd\xac\x00\xb3\xcd\x27\xba\xbf\xe9\x39\x81\xe3\x3f\xfd" +
+	"\x6c\xec\xaf\x2f\x69\x5e\x30\x72\x3f\x40\x75\x0d\x32\xd5\x96\xd7\xb2\x3a\x01\xea\x1b\x10\x28\x7c\x2d\x30\x2f\xf9" +
+	"\x84\xcd\x99\x03\x01\xd1\x30\x76\xab\x1c\x33\x7c\x85\x69\x9b\x17\x7b\xbb\xaa\x42\x82\x1e\x73\x5b\x54\xfe\x26\xd6" +
+	"\xa9\x80\x08\x1e\x0f\x16\x45\xbe\xd9\x74\xc3\x16\x2e\x46\x6d\x5a\x1a\xa8\x45\xa7\xff\xd8\x0b\xac\x56\x48\x20\xd4" +
+	"\x68\x5c\x06\x7a\x22\x46\x1a\x09\xd8\xbb\x0f\x27\xd3\x47\x78\xf3\xa4\x04\xdd\xf4\x46\x28\x18\xb7\x3e\xa4\xad\x8c" +
+	"\xda\x01\xa2\xa4\x87\x9a\x1d\xe3\x5f\x06\x40\x82\x49\xd8\x5a\xfd\xf7\xad\x81\x82\xda\x3b\x6a\xf8\xf1\x67\x6d\x68" +
+	"\x84\xa2\xde\x8b\x47\xa3\x6e\x64\xb7\x02\xd1\x81\x85\x5d\x2a\x74\x86\xb1\xf5\xe4\x3a\xa1\xd4\x0f\xeb\x39\x46\x43" +
+	"\xb1\xcd\xe6\x56\x9f\x93\xf6\xdb\x05\x46\x98\xbd\xea\x06\x83\x83\xfe\x27\x04\xbb\x98\xa7\xc6\x15\xa7\xcf\x8f\x13" +
+	"\x40\xff\xbf\x5b\x04\x12\xb4\xe8\x43\xcc\x7f\xb2\x5c\x54\xb8\xa6\x4f\xb1\x82\x3f\xe1\xe7\xec\xc7\xb7\x3f\xbe\x45" +
+	"\x64\x29\xf9\xd6\x79\xc6\xd3\xfc\xeb\x5c\xe3\x31\xf2\xb8\xc1\xa8\x99\x0c\xca\x02\x87\x7e\xa8\xd1\xc8\x5f\xe0\x88" +
+	"\x7a\xb3\xbf\x95\x27\xda\x36\x37\xcb\xd7\x41\xde\xdb\xc7\x5d\x20\xf8\xce\x15\x82\xb9\x85\x06\x9f\x7e\xae\x0b\xa7" +
+	"\xae\x5f\x0d\x77\xe0\xf5\x4c\xcd\x70\x76\x09\x5b\xe5\x5b\x66\x5c\xc1\x56\xe4\x33\x85\x2c\xaf\x4b\x1f\x37\xfa\xb5" +
+	"\x84\xb9\xbc\x78\xcd\xf1\xce\xc5\x2a\xf8\x22\x0a\x5c\x25\xea\xac\x12\x75\x56\x8b\x42\xfb\xa6\xfc\x6e\x3f\x5f\xd1" +
+	"\xf9\x27\x89\x8e\x64\xe5\xbb\xe9\xe9\x21\x92\xaa\x98\xf2\x96\xd2\x59\x32\xd7\x64\xaf\xb5\x6a\x5c\x15\x13\x84\x01" +
+	"\x65\x5e\xab\x96\xe1\xfd\x5c\xec\xd5\x5d\x5b\x15\x2f\x6e\x5b\xea\xfd\x8d\x8b\xe1\x64\x1a\xc4\xd6\x2b\x6c\xb1\x9e" +
+	"\xe8\x9b\x56\x6d\xf1\xda\x8e\x5e\x24\xf0\xa1\x88\xb9\x25\xd9\xa6\x0c\x4b\xbf\x66\xc4\x67\x2c\x67\x24\xed\xb5\xdc" +
+	"\xec\xbb\x77\x31\xfc\x34\xf1\x82\x8e\x12\x2f\x43\xa0\xee\xbf\x1d\xae\xef\x19\x8a\xa8\x1e\x7a\x6c\x36\x24\x5c\x6f" +
+	"\x75\xea\xcc\x73\xd4\xc8\x58\xe3\xe9\x91\x50\xe1\x64\x83\x63\xa1\xfb\xbd\x3a\xa9\xd0\xe4\x08\x1b\x0d\x61\x35\xfb" +
+	"\x5a\x53\xba\xb6\xce\xe0\x44\xd3\x30\x14\xf8\x46\xb1\x5d\xe2\xea\x72\xf4\xc7\xe4\x99\x15\x56\xcb\x42\xfc\x60\x54" +
+	"\xbc\x3a\x64\xff\x5e\xbd\xa7\x05\x3e\x6b\x2c\x72\x0a\x80\x36\x6e\x08\xc7\xd1\xa8\x4c\x96\xaf\x60\xd1\x8a\x5b\xe8" +
+	"\x31\x9c\xf8\xd3\x71\x50\x5b\x9f\xbb\x42\x5d\xf6\x9a\xc0\xb2\x8d\x83\xf4\xef\x0e\x80\xa9\xd7\xba\x41\xa6\x31\xb8" +
+	"\xac\xc0\xbd\x31\x96\xaf\x2d\xc3\x8d\x28\x26\x59\x66\x35\x52\x38\x8f\x6b\x2b\xfc\xbd\x4e\xc4\x94\x17\x11\xa0\xf3" +
+	"\xa1\xb5\x42\xbe\x88\x95\x5e\x37\x36\x44\x46\xc3\x4d\x8a\xbb\x45\x43\xbe\xd4\x34\x3d\x15\xf5\x46\xb5\xd0\x81\xdf" +
+	"\x2c\x4d\x07\x13\xb2\x26\x2b\xde\xce\xbf\xce\xc8\xf2\x0e\xc0\x8a\x99\xca\xdf\xbe\x1c\x9b\xe7\x99\xf7\x58\xf3\x82" +
+	"\x5e\xdd\x8e\x8c\x47\x27\xee\x47\x0a\xe3\xcb\x19\x79\x35\xd5\xc6\xd2\x93\x36\x7b\x9f\x98\xb9\xe9\xd1\x4f\xc2\xfa" +
+	"\xa5\x2c\x9c\xcb\xfa\x85\xcd\xb7\xb4\x31\x9b\xdf\x18\x4a\xda\x92\x8d\xcb\xf3\x14\x8d\xce\x47\x78\x39\xf0\xe1\x13" +
+	"\xfa\xa0\x60\xd8\xbe\x3a\xef\x51\x56\x7b\x24\x61\x1f\xbc\x06\xb0\x8d\xc1\xff\x00\x8b\x81\x35\x3b\x3f\x60\x82\xea" +
+	"\x59\x83\x4f\x3f\x94\xac\x98\x3c\xc2\x9a\x72\x66\x22\x8c\x67\xd6\x66\xee\x3f\xb9\xd4\xbe\xa1\x54\x81\x7a\x70\x2d" +
+	"\x91\x9b\x70\xd3\x5d\x5f\xab\x79\x8b\xa6\x11\x7b\x0a\x71\x3a\x16\xea\xb4\xb0\x5b\xb7\xd7\x76\xef\xf9\xe1\x44\xf3" +
+	"\x11\x0c\xd3\x80\xbf\xfe\x72\x7c\xbf\x53\xe1\x7b\x91\x90\x51\x6c\x35\x35\xad\xce\x31\x38\x72\x10\x51\x64\x45\x75" +
+	"\x6c\xbc\x84\xc1\xee\xa9\x0c\x10\x1f\x2c\x62\x61\x3c\x7a\x64\xab\xad\xd6\xb1\xf6\xd0\xd0\xa5\xd1\x0a\xf8\xf7\xc6" +
+	"\x7e\x47\xba\x2a\xcc\xec\x96\x85\x07\x9d\x86\x19\x4e\x1c\xdf\x8d\x86\xae\x55\x7e\x3c\x8c\x21\xfe\x6e\xab\xb6\x4d" +
+	"\x23\xba\x3a\xac\xd5\x79\xe4\x46\x3c\x35\x41\x92\x99\xd4\xe5\xc7\x36\x4a\x42\x11\x78\x88\x2a\x0e\x66\x2d\x38\xac" +
+	"\x61\xf0\x41\x0d\x31\x84\xde\x9c\x1a\x8e\x98\x1d\xe3\xf0\x35\x5b\xb3\x75\xa8\x51\x01\x2b\x28\x2f\x25\x19\x23\x49" +
+	"\x26\x2b\x09\x62\x81\x4d\x4a\x19\xe5\xaf\xb9\x36\x2b\x5e\x45\xe4\x6c\x03\x4b\x4c\x2a\x1d\x51\x83\xd4\x31\xfe\x0b" +
+	"\x3d\xc7\xf7\x7e\x76\x47\xf0\xce\x73\xdf\x6b\x95\x84\xdc\x8e\x39\x80\x9e\x93\xb4\xc0\x66\x4f\xc4\xba\x26\xbf\x3a" +
+	"\xaf\x39\x2e\xcf\x8f\x31\xe1\x5a\x13\x9f\xe2\x93\x2b\xde\xc5\x04\x8f\xe0\xbf\x15\xa2\x2a\xf6\xba\x8b\xc9\x7e\xa7" +
+	"\x14\x9d\xcd\xd3\x2d\x9f\x9a\xcf\xe4\xfb\x6f\x63\xd6\x22\x8e\xd1\x31\xc4\x1c\x1c\x4e\x75\x04\x69\x3d\xd8\x39\x94" +
+	"\x7b\x38\xe4\xe1\x34\x37\xff\x03\x06\x23\xbf\xe3\xaf\x1f\x00\x00")
 
 func bindataDbBasesqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -156,10 +192,10 @@ func bindataDbBasesql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/base.sql",
-		size: 3872,
+		size: 8111,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -168,52 +204,116 @@ func bindataDbBasesql() (*asset, error) {
 }
 
 var _bindataDbIndicessql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5b\x6f\xab\x46\x10\x7e\xe7\x57\xcc\x5b\x80\x72\x7c\x89\xaa\xaa" +
-	"\xca\x51\x2b\x11\x87\x24\x54\x36\xce\x31\xb8\xe7\xe4\xc9\xda\xe0\x4d\xb2\x2a\x37\xb1\xeb\xc4\xfe\xf7\xd5\x5e\xb8" +
-	"\x1a\x8c\x13\x9d\xf6\xc9\x0a\xcc\xce\x7c\xf3\xcd\xb7\xc3\x4c\xc6\xe6\xe2\x40\x92\x2d\xde\x43\x8e\xb3\x1c\x53\x9c" +
-	"\x30\x0a\xf2\x01\x3b\x64\x98\x42\xfa\x0c\x94\x21\x46\x28\x23\x21\x35\xc7\xda\x6c\xe5\xd8\x81\x03\xc1\xe3\x83\x03" +
-	"\xef\x97\xe9\x28\x56\xc7\x6d\x1f\x1c\x6f\xbd\x00\xfd\x22\x4c\x93\xe7\x88\x84\xec\xc2\x82\x8b\x2c\x8d\x70\x4c\xc2" +
-	"\x0b\xe3\xab\xa6\x8d\xcd\x1b\xfc\x4c\x12\xcc\xdd\x93\x10\xd3\xa7\xc3\x01\xa3\x1c\x18\x7a\x8a\x30\xb0\x57\xc4\xe0" +
-	"\x39\xcd\x01\xa3\xf0\x15\x32\xf4\x82\x21\x4c\x13\x86\x48\x42\x81\x9b\x45\x07\x28\xfc\x02\x4a\xb6\xa0\x1c\x57\xd8" +
-	"\xcc\xb1\x36\x36\x5d\xe9\x19\xe2\x1d\x65\xb0\x15\xd1\xb6\x40\x12\x40\xf0\x8e\x0e\x32\x46\x4c\x28\x25\xc9\x0b\xe0" +
-	"\x84\xe5\x04\x53\x08\xd3\x3c\xc7\x21\x8b\x0e\xdc\x1e\xed\x22\x06\x2c\x85\xc9\x68\x52\x4b\xd5\xbe\x9e\xcb\x5c\x9b" +
-	"\xc0\x6d\x5f\xfb\xee\x06\xf7\x80\x72\x46\xc2\x08\xef\x28\xce\x69\x1a\x12\x14\x29\x33\x4e\x89\xae\x01\x00\xf8\xce" +
-	"\xdc\x99\x05\x70\xe3\xfa\x81\xeb\xcd\x02\xf0\xd6\xf3\xf9\xd5\x55\x9d\xbd\xb1\x89\xf7\xe0\x8f\xe0\x21\xcd\x76\x11" +
-	"\xca\x09\x3b\x98\x63\x7e\x9e\xd7\xc0\x12\x74\x6c\xc8\xd6\x82\x1c\xbf\x6d\x54\x6c\xc1\x8a\x05\x3c\xea\x86\x6c\x45" +
-	"\x98\xdb\xd5\x72\x21\x70\xe6\xf8\x8d\x50\x92\x26\x54\x3c\xfe\x7e\xef\xac\x9c\xc2\x10\x5c\x1f\xbc\xa5\x84\x20\xde" +
-	"\xae\x3d\x77\xe9\x81\xad\xfe\x6a\x23\xad\x8a\xd9\xc4\xfb\x5f\x43\x03\xdb\xbb\x11\x2e\x09\xcd\xf1\x1b\xce\x19\xfc" +
-	"\x09\x13\xcd\xb0\x80\x24\x61\x1a\x67\x11\x66\x98\x47\xfe\x00\xe7\x05\xdc\x1c\x27\x8c\x07\xb3\xfd\x0a\x7b\x0f\xde" +
-	"\xde\xc2\xfe\xb5\x74\x3d\x91\x0d\xf7\xc0\x72\x8c\x61\xed\xbb\xde\x1d\xe8\xca\xa3\xd1\xcf\xac\x39\xec\x9e\xa7\xf9" +
-	"\xc9\xe4\x54\x3e\x93\x13\x45\x18\x20\xf0\x2c\xe4\x43\x3e\x0c\x4b\x53\xc9\x85\xe9\x2e\x61\x1c\xc9\x31\xf8\xcd\x48" +
-	"\x22\x9c\x2d\xd7\x5e\xa0\x9b\xc6\xd5\xd5\xed\x7c\x69\x07\x42\x5a\x29\x43\x11\x77\x2d\x8e\x37\x05\xc4\x48\x8c\x9f" +
-	"\xd2\x5d\xb2\xa5\x56\x59\x02\x6a\xc1\x0b\x4e\x70\x8e\x18\xde\x50\xcc\xaf\xb5\xac\x44\x98\x63\xc4\x48\x9a\x88\x40" +
-	"\x31\xda\xf3\x5f\x03\x36\xba\xf8\xad\x09\x50\x18\x73\x0e\xe1\x0f\xb8\x50\xc8\x4b\xc1\x8b\x1a\x1f\x32\x2c\xec\xef" +
-	"\x56\xcb\xf5\x03\x5c\x3f\x2a\xf4\xfd\x6c\xd5\x4a\xf0\xc1\x04\x45\x42\x1f\x06\x67\x58\x5a\x67\x2d\x44\x80\x23\xee" +
-	"\x5b\x7a\xe9\xc5\xf9\x8e\xc9\xcb\x6b\x0d\x5f\xbf\x64\x4a\x62\x3a\x5c\x4b\x41\x93\x1c\x6f\x15\xfc\x33\x10\xb6\xb0" +
-	"\x65\xd3\x91\xc4\x22\xee\x6d\xd9\x24\x2d\xc8\x2e\x6b\x2f\x8a\x66\xd5\x41\xa8\xbc\xb3\x27\x28\xca\xa6\x9d\x97\x78" +
-	"\xf0\xd8\x65\xeb\x98\x44\xdc\x50\xd7\x74\x24\xca\xc7\xdb\x5b\xd1\xda\xb2\xcb\x51\x51\xd2\xbe\x0e\xcb\xad\xce\x2c" +
-	"\x3d\xf8\x19\xca\x29\x76\xbe\x55\x9f\x0f\xe7\xdb\xac\xf8\x5c\xb6\xa9\x55\x8c\xd6\x48\x2c\x20\x54\x1a\x00\xc4\xbf" +
-	"\x8c\xdd\xda\x84\xac\x60\xe5\x74\x49\x7b\x18\x2d\x95\xd2\x8f\x83\xa7\x54\xa5\xd2\xdf\xf6\xda\x1e\x2a\xb0\xa7\x08" +
-	"\xe1\xde\x7b\xc9\x69\xf9\x3e\x56\xd4\x90\xe7\xc7\xce\x66\x57\x36\x8b\xce\x3e\xd6\x6e\x5e\x31\x39\xd1\xb2\x4e\xb7" +
-	"\x1b\x0e\xe1\xa7\xe8\xc0\x9e\x3b\xfe\xcc\xd1\x45\x29\xad\x89\x21\xaf\x57\x43\x10\x32\x53\x21\x85\x5a\xb1\x54\xc9" +
-	"\x2b\xbc\xc2\xa0\x04\x71\xf4\x7a\xee\xdc\x06\xd2\xe6\xa4\x88\x6b\xe7\xba\x31\x1b\xed\xcc\xef\x9c\x4f\x65\x2e\xed" +
-	"\xd6\x0b\x99\xb9\x01\xcb\xbf\x9d\x15\xe8\x0f\xf6\x2a\x70\x03\x4e\xfc\xf5\x63\xe3\xa0\x18\x79\x96\xab\x1b\x67\xc5" +
-	"\xdf\x94\x23\xea\x8d\xe3\xcf\xba\x2e\x51\xbf\x6e\xe6\xce\xff\x01\xbd\x32\x6e\x02\xaf\x1c\x0e\xa1\xae\xb0\x71\xd4" +
-	"\xbb\x84\xeb\xb8\x6c\x02\xc5\x54\x3e\xd0\xcd\xf5\x02\xc6\xb8\x16\xd7\x8c\xd2\x17\xbd\xf9\x61\x1c\xab\x44\x3a\xbf" +
-	"\x44\x27\x9b\x4f\xa5\xbc\x1e\x5e\xcf\xd0\x53\xe9\xe2\x78\x96\x69\xa8\xd8\xb0\x40\x8b\x49\x12\xa3\xbd\x32\xe4\x94" +
-	"\x50\x86\xe2\xac\x9f\x87\x85\xeb\xe9\xc5\xc4\x2c\xf2\x2b\xae\x3c\x2c\xec\x1f\xad\x37\xb2\x09\x58\xc2\x4f\x71\xae" +
-	"\x0c\x51\x1c\x2e\x1f\x54\x1e\x5a\x36\x68\x5f\x3e\x38\x35\x92\x97\xed\x59\x61\xe5\xe9\xf1\x83\xb2\x00\xc7\x9d\xad" +
-	"\x59\x5a\xf1\xc6\xf9\x11\xac\xec\x59\xa0\xe3\x2c\x0d\x5f\x65\x1c\x7d\xee\xd8\x7e\xa0\xd7\x41\x58\x31\xfa\x07\x6f" +
-	"\xb6\x88\x61\xc1\xe3\x2f\x53\x6b\x6a\x4d\x0d\xe3\xcb\x9d\xd8\xba\xb8\x71\x3d\xab\xa6\xb1\x34\x35\x8c\xf1\xef\xbf" +
-	"\xfd\x3a\x99\x8c\x26\x5d\x0a\xe9\x2e\xc9\xcf\x68\xb6\x5d\x73\x76\x57\xce\xf5\x6c\xbf\xd4\xb3\xf9\x0c\x70\x5e\x87" +
-	"\xa1\x6b\x56\xae\xdb\x43\xcb\x9a\xc4\x8c\x32\x35\x3b\x99\xec\xbd\x36\x45\xb5\xf1\xf4\xdc\x71\xa4\xc6\x80\xba\x38" +
-	"\xd8\x7b\xcf\x34\x64\x58\x5a\xdf\x12\x63\x0e\x8c\x97\xd5\x7d\x96\xe3\x54\x39\x4b\x0d\x6e\xb1\x66\x63\xcf\x2a\x80" +
-	"\xf7\xdb\x0f\xd3\xd7\xbf\x3c\xf2\xb6\x2b\x59\xe8\x6c\x57\x6d\xf2\xce\xdb\x20\x6b\x77\x51\x45\x2e\x07\x6a\x4d\xfe" +
-	"\x77\xe6\x9c\x8d\xb0\x5c\x5e\xd4\xee\xd6\xb1\xc3\xd2\xa3\xf0\xfc\xd6\x1f\x32\xb1\xdf\x15\x5b\xfd\xa9\x0d\xa2\x06" +
-	"\xb0\x8c\x69\xd5\x97\xa4\xae\x69\x5c\xa6\x50\x8f\xcc\x9f\x18\xb2\x89\x0c\xcd\x48\x67\x2e\x78\x9d\x85\x3e\x0b\x7c" +
-	"\x35\x52\x7d\x10\xbf\x66\x68\x03\x71\xe4\x0a\x98\x91\xb0\x1d\xb3\x58\xc4\xd4\xf0\x25\x55\xa4\xa6\x2f\x25\xa9\x46" +
-	"\x11\x57\xee\xdd\x7d\x50\x81\x29\xab\xa5\x30\x75\xdc\x7a\xe3\xeb\xbf\x01\x00\x00\xff\xff\x74\x41\x4b\xa6\xf2\x13" +
-	"\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x1b\xdb\x72\xda\x48\xf6\x9d\xaf\xe8\x99\x17\x10\x21\xc6\xce\x6e\x6d" +
+	"\x6d\x39\x95\xa9\x22\xb6\x92\xb0\x85\xc1\x01\x9c\x99\x3c\xb9\x64\xe8\x18\x55\x40\x62\x25\x11\xcc\xdf\xef\x39\xa7" +
+	"\xef\xba\x42\xe2\x4a\x4d\xd6\x0f\x89\xa4\x3e\xdd\x7d\xee\x97\xee\x43\xbf\x7b\x73\x08\xa3\x25\x7f\x62\x09\xdf\x26" +
+	"\x3c\xe5\x51\x96\x32\xf1\x21\x3b\x6c\x79\xca\xe2\x2f\x2c\xcd\x82\x2c\x4c\xb3\x70\x91\x76\xfb\xad\xab\xa9\x3f\x98" +
+	"\xfb\x6c\xfe\xf9\xd6\x67\xfb\x57\xf1\xd9\x46\x4e\x1f\xcc\x98\x3f\xbe\xbb\x61\x9d\xf6\x22\x8e\xbe\xac\xc3\x45\xd6" +
+	"\xee\xb1\xf6\x36\x5e\xf3\x4d\xb8\xc0\x47\xbe\x0c\xb3\x7d\x90\xe0\x63\xc4\xf7\x8b\x78\xc3\x93\x84\x7f\xe3\x09\xc1" +
+	"\x05\x51\x1c\x1d\x36\xf1\x2e\xc5\x97\x87\xd8\xfd\x66\xc0\x60\x44\xaf\xee\xbd\x6e\xb5\xfa\xdd\x61\xb4\x0c\x17\x80" +
+	"\x66\x10\x2d\x59\xb6\xe2\x61\xc2\x16\xf1\x0e\x69\x08\x12\xce\xbe\xf2\x03\x5f\xb2\x87\x03\x3b\xf0\x20\x21\x90\x2d" +
+	"\x4f\xc2\x78\x79\x49\x1f\xd6\x07\x16\x47\x30\x75\x15\x7c\xe3\x30\xc8\xf8\x66\x9b\x1d\x24\x44\x4f\x4c\x39\x47\xda" +
+	"\xa3\x65\xca\xbe\xc4\x09\x2e\xcf\xf6\x2b\x20\x08\x80\x83\xf5\x21\x0d\x69\xd7\x5e\x6b\x17\xad\x79\x9a\xb2\xc7\x24" +
+	"\x88\x76\xeb\x20\x09\x61\x11\x18\xc2\xf9\x3d\x96\xee\x1e\x5e\xd2\x4a\x66\x27\x5c\x46\x6c\xc2\xd6\xc1\x03\x5f\x13" +
+	"\x5e\x61\x26\x66\x9c\xb1\x2b\x81\x3e\xb0\x1d\xc8\x0e\xd3\x30\x8e\x70\xe9\x78\xb7\x45\x3a\x70\x6e\xb6\x4a\x38\x91" +
+	"\x06\xdb\x67\xb0\xee\x82\xf7\x5a\xe9\xd7\x70\xbb\x0d\xa3\x47\x02\x70\xf7\x74\x16\xda\x87\xd9\x2a\xde\x65\x72\x7f" +
+	"\x90\x26\x70\x70\x16\x2f\xc2\x60\x2d\xb8\xf6\x70\xa0\x89\x48\x19\xcb\x80\x1f\x71\x12\x24\xb0\x6b\xf0\x00\x44\xe3" +
+	"\x5c\xb6\x8d\xb7\x8a\xc6\xce\xf8\x6e\x34\x22\x25\xf1\x7a\x4c\x49\x85\x88\xb1\xa4\x84\xdb\xa3\xf4\x80\xaf\xc1\x23" +
+	"\x2f\x11\x45\x8f\x85\x67\xfc\x8c\xe8\x5f\xa2\x8e\x45\x30\x07\x35\x25\x4e\x84\x4c\x85\xec\x79\x82\xaa\xd7\xef\xbe" +
+	"\x8d\xa5\x64\x09\x5d\x90\x6d\x18\xd9\x28\xe1\x04\xb3\x73\x04\x02\x26\xa4\x01\x1f\x80\x82\x8f\x20\x98\x68\xb1\xde" +
+	"\x2d\x79\xaf\x30\x90\xf2\x6d\x90\x04\x19\x97\x1b\x22\x2f\x1f\x4a\x36\xb3\x48\xb3\x6c\x61\xf0\x76\x24\x8c\x21\x2d" +
+	"\xf2\x72\x30\x6b\xfd\x39\x9c\x7f\x80\x85\xc0\x80\xd6\x7c\x97\x02\x2d\x04\x15\x4a\xcd\x05\xc3\xe9\xb4\x18\xfc\xcd" +
+	"\xfc\x91\x7f\x35\x67\xc8\xd6\xcb\x4b\xdb\xb4\xfa\x5d\xf8\x67\x76\xc6\x6e\x35\xa5\xdd\x3e\x4e\x43\xde\xf7\x88\xb1" +
+	"\xf7\x21\x30\xf2\x6a\x32\x18\xf9\xb3\x2b\xbf\x03\x24\xdc\x0b\xf5\x3b\xf7\x10\x4e\x3c\x3b\xc3\x8a\xf9\xed\x36\x41" +
+	"\xa8\x57\xc4\x0e\xd6\x22\x74\xde\x4d\x27\x37\x44\x94\x56\x1f\xfa\xfc\xe7\x07\x7f\xea\x2b\x40\x36\x9c\xb1\xf1\x44" +
+	"\xe0\xcc\x06\xe3\x6b\x50\x0a\x78\x13\x83\x29\x72\x77\x32\x65\x97\x68\xb5\x82\xcb\x6d\xf6\x86\xb5\xa5\x08\xda\x1e" +
+	"\x2d\xf7\x7e\x3a\xb9\xbb\x65\x6f\x3f\x8b\x87\xe1\xf8\x3d\xb0\x61\x0e\x1c\xe9\x68\xb2\x0c\x35\x72\x53\x50\x37\x33" +
+	"\x5a\xf6\xcd\xcc\xb0\x49\x55\x90\x62\xdf\x0f\x83\x4f\xb8\x99\xda\xd5\x62\x8a\x07\x48\x5e\x20\xe2\xe6\x93\x4d\x26" +
+	"\xcd\xbe\x1b\x0f\x27\x63\x36\x90\x6f\x52\x70\xc6\xf1\xb9\xe2\xfb\xf5\x25\x45\x13\x10\x99\x50\xfa\x62\xf6\x07\x3b" +
+	"\xff\xbf\x13\x9f\x1d\x5c\x7e\x05\x09\x5a\xc2\x2b\x93\x0f\x7d\xcc\x8b\x54\xb9\xb9\xf6\x2f\x2a\x3d\x4f\xc8\xa0\xd9" +
+	"\x8b\x5e\x0f\x67\xf3\xe1\x18\x1e\x94\xe0\x12\xc8\x6c\x90\x8b\x28\x01\x85\xab\xc0\xb3\x52\x20\x95\x2e\xfb\x3f\x93" +
+	"\xe1\x98\xc4\x85\x2b\x65\x18\x8e\xef\x66\x48\x90\xe2\x82\x57\xad\x65\xdd\xe6\xe5\x5b\x5e\x4b\x02\xe7\x94\xce\x45" +
+	"\xf7\x6a\x72\x37\x9e\x77\xba\xde\xe5\xe5\xbb\xd1\x64\x30\x47\xc2\xf6\x3c\x7c\x5c\x65\x2d\x5a\xbd\x94\x4d\x2d\x2d" +
+	"\xf1\x9a\xa5\x29\xaf\x9a\x87\x1b\x2e\x96\x6b\x4e\x0a\x30\xe9\x58\x06\x07\xca\x35\x82\x45\x16\x7e\xc3\x58\xac\x03" +
+	"\xbf\x24\xb3\x07\x60\x90\xaf\xc0\x2a\x36\x38\x3e\x8b\x5c\x25\x51\x49\x11\x2e\x09\x91\x16\x33\x82\x2f\x61\x92\x8a" +
+	"\x94\x62\x1d\xc0\x83\x32\x0e\x4a\x05\x3e\xc3\xac\x94\x3d\xf0\x6c\xcf\x79\x44\xeb\x18\x68\x7c\x73\x66\x50\x18\x0f" +
+	"\xd6\x6b\x26\x53\x5c\x45\x6a\x2a\xb2\x84\x50\x20\x22\x31\x85\x34\x2d\xb5\x72\xa6\x90\x56\xdf\x94\x85\xfb\xac\xc0" +
+	"\x24\x15\xed\x37\x61\xb4\x09\x9e\xe4\x82\x08\x06\x79\xe4\x66\x5b\xd0\x52\xcd\xff\x9b\xe1\x58\xbb\x12\xf2\x12\xb0" +
+	"\x80\x90\xc9\xcd\xe0\xaf\xdc\x48\xf0\x44\x23\xb4\x8e\x9a\xa7\xb7\x50\x93\xf5\x07\xb3\x42\x0e\x26\x78\xd2\x1f\xea" +
+	"\x7c\x90\xd6\x18\x89\x2b\x9a\x60\xba\x0d\xa2\xb4\x9a\x18\x81\x78\xbb\x6d\x7b\xbb\x4d\xf0\x95\xdf\x2f\xc1\xf3\x74" +
+	"\x68\xf4\xa2\x77\x01\x8a\x3b\x1f\xde\xf8\xb3\xf9\xe0\xe6\x16\x21\x69\xd1\x0c\x58\x96\x07\x7e\x71\x51\x05\xce\x23" +
+	"\xcb\x5a\xcb\x59\xde\x63\x8f\x3c\xe2\xe8\xf2\xee\xc1\x18\x42\x9e\x76\x14\x67\x25\x1f\x3d\x76\x4f\xbb\xd4\xd8\xac" +
+	"\x26\xec\xdc\x78\xf8\x1c\x75\x16\xc3\x0b\xb4\x3c\x15\x86\x8e\xc0\xfb\x08\x6c\x94\x56\x18\xa4\x2c\xa7\x69\x21\x67" +
+	"\x90\xa1\x65\xf4\xeb\x8b\xce\xd5\x60\xe6\x43\x88\xb0\x2a\x97\x36\xc6\x9c\x31\x6b\xff\x77\x17\x60\xd6\xdd\x66\x73" +
+	"\x7a\xfd\x07\xdb\xc4\x51\xb6\x4a\xdb\xcc\x1f\xc1\x94\xf6\x85\x78\x87\xd7\xf1\x35\x08\x66\x38\x9e\xfb\xd3\x4f\x83" +
+	"\x51\x29\x7d\x42\x47\xea\x08\x70\x43\x05\xca\xfd\x3e\x4b\x76\xd1\xa2\xe3\xa2\xd6\x2b\xd1\x76\xcf\xe1\xb6\xde\xa9" +
+	"\x42\x97\x4d\x4c\xad\x49\x0e\xca\x94\xbe\x1c\x57\x02\x07\xfd\x31\x0e\xbb\xdc\x55\xb7\xfc\xbf\xe6\xd3\xc1\xd5\xbc" +
+	"\xc3\xb7\xf1\x62\x25\xb9\x32\xf2\x07\xb3\x79\xc7\xd6\x8e\x9e\x64\x9d\xf7\xf2\x3d\x39\x1a\x1c\xb6\x0d\x59\x93\xe9" +
+	"\x79\x5e\xff\xdf\xff\xfa\xe7\xf9\xf9\xd9\x79\xde\xeb\x0b\xcb\xa4\xf8\x54\xe1\x82\x72\x91\x8a\x9c\xfd\x35\xff\x12" +
+	"\x46\x9c\xc9\x20\x21\x5d\x99\x70\xf0\xe4\xb4\xb1\xfa\xe5\x01\xa0\x4e\x45\x1c\x24\x49\x59\x10\x46\xa9\x2a\xa2\xd1" +
+	"\xe3\xea\x9a\xd3\x29\x04\x65\xf5\x6f\x0e\x10\xc8\x73\x5f\x01\x48\xb8\xe4\x50\xb8\x62\x34\x30\xe5\x5b\xbf\x63\x9e" +
+	"\x5f\xc8\xa9\x5b\x60\x20\x98\x28\x22\xa0\xbc\xb3\x5a\x14\x37\xb8\x60\x31\xf8\xe6\x64\x1f\xa6\x9c\x96\x56\xa7\x01" +
+	"\x9b\x1d\x38\xff\x25\x51\x45\x65\x5b\xc0\xf6\xc1\x41\xd0\xb2\x09\xd3\x14\xab\x3b\x88\x02\xe8\x0e\x00\xe1\x24\xe1" +
+	"\x8b\x0c\xe8\x00\xf8\x60\xb7\xce\x58\x16\x33\xe0\x2c\xad\x37\x53\x64\x29\x68\x8c\x23\xc0\xe3\x24\x45\x6e\xa5\x19" +
+	"\x0f\x96\x97\xc4\x14\x53\x5a\xeb\x00\x48\xdb\x4a\x1d\xc3\x69\x72\x63\xb0\xc7\x18\x03\xca\x81\x3e\x82\x7a\x7f\x05" +
+	"\x14\x03\x30\x27\x51\xbc\x8b\xc5\x68\x11\x2e\x43\x8f\xa9\xd6\x0b\x01\xc8\x95\x98\x0a\x3e\xa5\xa1\x9f\x8a\xd2\x82" +
+	"\xcf\xee\xba\xae\xbf\x58\xbf\x8a\x8c\x2b\x4c\xf8\x52\xf2\xff\x88\x45\x2b\x12\x96\xed\xc5\x99\x50\x55\x72\x4e\x5a" +
+	"\xd4\x30\xf0\xca\x1a\x50\x0a\xe4\x22\x26\xd8\x42\x7a\x5d\x43\xdd\xf6\xa2\x34\x11\x6b\x9c\xf6\x2a\x37\xcd\xc5\xdc" +
+	"\xb3\x72\x71\xa0\x01\x33\x27\x72\x1a\x2a\x0f\x07\xf4\xe9\xdb\x9b\xea\x0a\x10\xa1\x70\x69\x05\x27\x79\xa9\xc1\x28" +
+	"\x8f\x84\x31\xe4\xf6\x8c\xd4\xcb\xff\x68\xaa\x7d\xff\xe3\x95\xb2\xaa\x3c\xab\x73\x1c\xb6\x98\xaa\x50\x31\x89\x22" +
+	"\x0b\x52\x71\x94\x61\x58\xdb\x20\x59\x37\x01\x68\xde\x0b\xd1\x37\x68\x57\xe7\xe4\x55\x2b\x19\xc4\xea\x98\x80\xbb" +
+	"\x54\x32\xa4\x62\x8f\xa2\x56\x35\xec\x80\x29\x70\x9c\x05\x6b\xe5\xf2\x50\x4f\x64\xee\x1a\xed\x36\x0f\x3c\xa1\x64" +
+	"\x57\x32\x8e\x2d\x12\x1e\xe0\x09\x11\x40\x85\x6b\x02\x02\x3f\x5e\x9e\xdf\xf6\x9c\xc3\xbf\x14\xbc\x01\x94\x6b\x41" +
+	"\x6a\x19\x3a\x1e\x75\xc9\x95\x69\x5d\xca\x42\xf3\x74\x1e\x1b\xba\xb3\xf8\x7e\xb1\x0a\x12\xa1\xd9\x6a\x39\x48\x5f" +
+	"\x3e\xc3\xdf\xcb\xdf\x3f\xfe\xfe\x11\x8a\x52\x8a\xe9\xb5\x80\x37\x37\x08\x06\x5a\x6c\xa5\x15\x5a\xad\xd0\x68\x05" +
+	"\xfd\x45\x9b\x35\x04\x38\x35\x53\x5a\x6a\xa7\xd2\xc4\x8e\x31\x14\x59\xdc\x3a\xe4\xff\x06\xf0\xc8\xeb\x5c\x6d\x7b" +
+	"\x81\xfa\xa2\xf8\x89\x72\x3c\x50\xe5\x90\x67\xe8\xfd\x59\x59\x62\x57\x52\x64\x65\xa8\x16\x44\x9b\x6b\x4b\xaa\x20" +
+	"\x78\x80\xcf\xcb\xb4\x67\x68\x2d\xa6\xa0\x0e\x8f\xab\x93\xd1\x13\xf8\xe1\x92\x2c\x68\xa9\x4e\x21\x2b\xf3\xd8\x13" +
+	"\xc9\x25\xf2\xbe\x0b\xd5\x52\xb4\x5c\x93\xed\xcc\xee\x6e\x3a\xfa\x20\x45\x6a\x58\xef\x1c\x52\xbe\xc9\x27\x7f\xca" +
+	"\x3a\x93\xe9\x35\xfc\x87\x39\x9a\xf0\xd2\xc7\x20\x5d\x30\xab\x77\xe8\xc4\x49\x35\x3b\x75\x0e\x44\xcc\xb6\x5c\x9b" +
+	"\xa4\x57\x0c\xa2\xe2\x81\x7d\xdc\x6b\xa5\x96\x61\x03\xf4\xee\x59\xdd\xb8\x62\x05\xd2\xd4\x13\xc7\x4d\x39\xfa\x2c" +
+	"\x0c\x89\x28\xbd\xa1\xc4\xac\x18\xd6\x46\xfe\xbb\xb9\x80\xad\x8d\x3b\x25\xf3\xcb\xf1\x2c\x50\xfd\xde\xff\x11\xaa" +
+	"\x05\x38\x28\x02\x11\xaa\x44\x7f\x3b\x98\xce\x87\x73\xd4\x20\x14\xbf\x35\xcf\x91\x99\x56\x10\x9d\x93\x5e\x03\xff" +
+	"\xca\xc2\x60\x75\x9c\x19\xf9\x3f\x91\x12\x23\xeb\x72\x3a\xcc\xba\x4d\x44\x18\x54\x91\x88\x1d\x15\x11\x3a\xcc\xab" +
+	"\x04\xfa\xc8\xb4\xad\xa3\xd0\xea\x5b\xfb\x77\xd7\xf1\x63\xc7\xb5\xb2\xbe\x24\xcc\x54\x24\x27\xa5\x19\xa4\x83\x15" +
+	"\xec\x3e\x41\xfb\xf4\x52\x45\x8f\x5f\x6a\x03\x10\xe9\xad\x53\x9c\xa6\xfc\xb8\x70\xe0\x23\x32\x85\x81\xa4\x4c\xe7" +
+	"\xfe\xf2\x0e\x12\xf2\xf7\x38\xc2\x9c\x3e\x15\xf1\xdd\x1c\x2a\xe5\x4f\xc7\x4a\x6f\xc6\xf4\xe1\x9a\xbe\x09\xed\xd1" +
+	"\x95\x95\x75\x2f\xb6\x34\x63\x74\xdd\x67\x52\x07\x83\x4c\xa5\x9c\x9f\xe1\x10\xdb\x4e\x02\x34\x75\x42\xd7\xf5\xd0" +
+	"\xbb\xe1\x68\x8e\xba\x5e\x3c\xcd\x06\xe7\x4b\x33\x0d\x7d\xcd\x33\xf1\xbc\x9b\x26\xc1\xff\xa7\x6c\x54\x38\x23\xe7" +
+	"\x4b\x77\x6f\xf1\xf5\xa8\xa3\xb0\xc6\xe3\x72\xeb\x44\xbc\xf1\x70\xfc\x19\xce\xc4\x2d\x15\x14\x4a\x43\x17\xd2\x50" +
+	"\xee\x46\xaa\x5a\xde\x84\x11\x15\x3f\xfa\x6e\x15\xf3\x51\x3d\x64\xf4\x32\x7f\xec\x99\x3f\x9c\xc5\x9a\x35\x12\xb7" +
+	"\xa6\x38\xd7\x52\xb7\x2a\xaf\xa2\x5b\x00\x9a\xae\x54\x5c\xeb\x0e\xb6\xb2\x26\xec\x66\x7b\xab\x3a\xcc\x7b\x96\x0a" +
+	"\xd7\x16\x6c\x85\x13\xb0\x4d\x3b\xdb\x1f\x51\xe5\xc9\x52\xb1\xbe\xde\x3d\x72\x99\x82\x21\x1e\x5d\x64\x9a\x28\xfe" +
+	"\xc7\x1b\xc8\x72\x73\x32\x6c\x2b\x55\x96\x92\xca\xc1\xe8\x81\xb6\x95\xf8\x2a\xce\x2c\xcc\xd9\xcb\x33\x09\xaa\xfc" +
+	"\xfc\x86\xbd\x30\x38\xd1\x19\x4e\xdb\x53\xc5\xbd\xd8\xfe\xf8\xe0\x40\xea\xed\x83\xda\xb2\xbd\xb8\x85\x70\x6b\xb0" +
+	"\xcd\x2e\xdb\x41\x99\x26\xac\xd7\xf6\x7f\xb2\xdf\xa4\x48\xa7\x6a\x44\xf9\x09\x77\x7c\xd5\x77\x34\x8e\x9b\x91\x18" +
+	"\xfd\xfd\xbd\xcc\x58\x36\xee\x48\x76\x2b\x69\xa4\x2b\xf4\x0b\x20\x0c\x71\x05\x83\xe4\x50\x88\x13\x2e\x87\x8e\xdd" +
+	"\x1e\xe3\x4c\xc7\x2c\xa3\x95\x79\x6f\x45\x0b\x81\x0e\x6b\x93\xb1\x17\x2c\xf7\x48\xd4\x53\x03\x3e\x5c\x1c\x00\xce" +
+	"\xe3\x2d\x98\xbf\xd5\x02\x24\x1a\x62\x36\xfa\xb2\x69\xa1\xdb\x6b\x44\xb7\x90\xc2\xc4\x52\x1c\xd5\x9d\x94\xfe\xb4" +
+	"\xc8\xa9\x77\xec\x55\x05\xb3\x92\xc0\xa5\x5e\x5c\x55\xd2\x4b\xfd\xbd\x75\x49\x9c\x73\xba\x7d\x60\x45\x4b\xcd\xf5" +
+	"\x89\x9d\xe6\x98\x14\x7f\xa4\xed\xf5\x35\x67\x6a\x8c\xb1\xa0\x02\x96\x57\x36\x6a\x41\x0e\x37\xaf\xaa\xed\x9a\xce" +
+	"\x82\x63\xe9\x68\xbe\xa3\xc6\x02\x42\x6b\x81\xa2\x0c\x3f\x6a\xec\xbc\x53\xc8\x3b\xf2\x26\xdb\xba\x0e\x91\x28\xba" +
+	"\x78\xc9\x14\x44\x67\xa2\xb2\x09\xcc\x18\x20\xf2\x4a\x5c\x0f\x17\x60\x4c\xd6\x78\xbc\x57\x68\xca\x52\x5c\x9f\x60" +
+	"\xf0\x72\xbd\x96\x89\x21\x25\xc9\xb4\xde\xe2\x35\xcb\x7e\xc0\xa7\x90\x9a\x57\x66\xe2\x5d\x57\x2c\x0a\xae\xee\xee" +
+	"\xef\x68\x15\x11\xd8\x7b\xe2\x5d\xd3\x25\xdf\x31\x87\xce\x8d\x08\x1e\x78\x45\x65\xd1\xd8\x3f\x9b\xae\x08\xe3\x27" +
+	"\xa9\x2e\x9b\xab\x94\x5c\x5e\xa8\xf0\x55\xca\xaf\x49\xb5\xf4\xde\xad\x97\xea\x20\x61\xd8\xce\x44\x1e\xab\xc8\x3d" +
+	"\xee\xc0\xf2\xb7\xba\x13\x4b\xe1\x0e\xed\x9c\xad\xe8\x42\xc0\x8e\xaa\x1a\x5a\xba\x2e\x9e\xe5\x57\x19\x16\x56\xfa" +
+	"\x9a\xa2\xb1\xf1\x29\xa7\x85\x32\x6b\xab\x71\x67\x8d\x89\xe2\x71\x4a\x2a\xbc\x93\x57\xe7\xa8\x54\x3e\xff\x4c\xaa" +
+	"\x77\x2c\x0b\x64\x3e\x56\x0d\xff\xec\x84\xaa\x64\xf5\x44\x42\x73\x78\x9c\x48\x6e\x49\x0c\xae\x91\xba\x69\xd1\x3e" +
+	"\xb1\x92\xd3\x3e\x55\xd3\x6f\x6d\xef\x7a\x81\xfa\xee\xbc\x13\x37\xa6\xc6\xb8\x1f\xd9\x32\xdf\x80\xfe\x9d\x74\x8b" +
+	"\xd9\x85\xb0\x5c\xe2\x73\xe8\x34\x8a\xba\xee\x1b\xdb\xd9\xa4\xcb\x31\x9a\x54\xda\x9b\x56\x74\x5b\xe0\x7d\x71\x1e" +
+	"\xbe\xab\xde\x91\x4e\x99\x66\x4b\x92\xca\x6e\x45\x2a\x6f\x66\x05\xea\xf6\x8e\xa2\x3b\x9c\x26\xe4\xaf\x47\xbe\xf3" +
+	"\x4e\xa4\xd6\x1c\xeb\xdb\x74\x4e\xc5\xbb\xb2\x0f\xcf\xb1\x7b\xca\x0e\xe4\x67\x39\xb3\xd8\xa9\x27\xcb\x01\x21\x78" +
+	"\x79\x74\x2f\x5f\xec\x73\x7d\x55\x1c\xf7\x2e\xf2\xd5\xb2\x23\x62\x73\x69\xe1\x88\x52\x12\x50\xa3\x94\x5e\xab\x41" +
+	"\x3b\xcc\x6d\x40\xf5\xb9\xc1\x11\xdb\x50\x53\xc9\x34\xd8\x3b\x8d\xf0\xba\x6d\x84\x4e\x34\x75\x81\x97\xc4\x1b\xb6" +
+	"\x5f\x85\x8b\x95\x26\x4f\xb4\xdc\x6f\xb6\x3b\x4c\xde\x9d\x5f\x1c\xe4\x7f\x21\x60\xff\xf0\xc0\x0c\xea\x9f\x0c\x78" +
+	"\xf4\xfb\x0c\xbb\x79\x04\xf4\x0e\x75\x50\x59\x60\x27\xdf\xb5\xe8\x51\xc2\xe8\x17\x9a\x3d\xc4\x0f\x46\x4a\x9a\x3c" +
+	"\x9c\xc6\x0e\xd5\x53\xa8\x0e\xc9\xe4\xef\x06\xca\x1a\x37\x92\x1c\x73\x74\xeb\x46\xf5\xed\x79\x8e\xd5\x65\xad\x14" +
+	"\x8d\xcd\x1c\x25\xe9\x81\xee\x62\x10\xa9\x33\x5e\x65\xb5\x6c\xbe\x1e\x8d\x46\x79\xe3\x46\x33\x12\x0d\x6d\x13\x2e" +
+	"\x5a\x75\x67\xf5\x95\x98\x99\x49\x4d\xa7\xf9\x56\x52\x67\xef\x5b\xd1\xde\xa5\x2d\xd7\xba\x8a\x10\xb6\x6d\xdf\x4d" +
+	"\x38\xf6\x4d\xd7\x3b\xe7\x9e\xcd\x2f\x0b\xc2\x20\x24\x61\x2c\xcc\x45\xb4\x30\xea\x61\x9c\xc0\x22\x77\x4d\xe2\x60" +
+	"\xe9\xb5\x2c\x6f\x61\x31\xaf\x14\xf6\x75\xeb\x7a\x3a\xb9\xad\xfd\x3d\x4b\x01\xa4\xc0\xc4\xd7\xad\xff\x01\x11\xc0" +
+	"\xc1\xc8\x54\x36\x00\x00")
 
 func bindataDbIndicessqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -232,10 +332,110 @@ func bindataDbIndicessql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/indices.sql",
-		size: 5106,
+		size: 13908,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792370647, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataDbQualitysql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xd1\x6e\x9b\x30\x14\x7d\xe7\x2b\xee\x1b\x49\x14\x96\xb4\xd2\x5e" +
+	"\xda\x6e\x12\x4b\xa9\x16\x89\x85\x8d\x50\x75\x6f\x95\x03\x4e\xe3\x35\xd8\xd4\xbe\xb4\xcd\xdf\xef\xe2\x00\x21\x2b" +
+	"\xa9\xb6\x87\x49\x79\x08\x3e\xc7\xe7\x9e\x73\x6d\xdf\xc9\xe8\x47\xc9\xb6\x02\x77\xe9\x86\xa7\x8f\x06\x52\x25\x91" +
+	"\x09\x69\xc6\xb0\x56\x1a\x38\x4b\x37\x90\x31\x64\xf0\xb4\x67\x81\xa5\x8d\x01\x37\x9c\xa8\xa5\x44\x50\x6b\x58\xb3" +
+	"\x72\x4b\x90\x40\x9e\x1b\x50\xcf\x5c\xef\xe1\x8a\xc9\x33\x50\x92\x9b\x4b\x02\x21\x2f\x0d\xc2\xaa\xda\x97\x17\x25" +
+	"\x12\xb2\xe2\x54\x83\x5b\xb2\xe6\xcf\xc2\x08\x25\x0d\x20\x5b\x6d\x39\x08\x03\x99\x56\x45\xc1\xb3\xd1\xc4\x99\x8c" +
+	"\x7c\x8d\x22\xdd\x72\x03\x2f\x02\x37\xaa\xc4\x0e\x9f\xe9\xda\x09\x09\x96\x85\x92\x20\xf2\x42\x69\x1c\x77\xd4\x77" +
+	"\x96\x74\xd0\x9b\xc5\x81\x9f\x04\x90\xf8\x5f\xc2\x00\x5e\xce\xd5\x87\xa7\xa3\x16\xf8\x4b\x67\x19\x84\xc1\x2c\x01" +
+	"\x97\xd5\x75\xbd\xba\xae\xd7\xd6\x75\x89\x06\x92\xe5\x7c\x0c\xee\x91\x3b\x90\x0a\x8e\x59\x19\x37\xa9\x16\x05\xd2" +
+	"\xc2\xb8\xee\x15\x35\x50\x21\xdb\x3a\x37\x71\xf4\xcd\x3a\x68\x76\x90\x8a\x69\x8a\x3a\xb7\x8b\x79\xb4\x00\x3f\x0c" +
+	"\x5b\x3f\xa8\x0a\x91\x1e\xdc\x34\x4c\x97\x4c\x24\x16\x6a\x2d\x1c\x20\x67\x16\xdd\x2e\x92\xc1\x68\x08\x37\xf3\x30" +
+	"\x09\x62\x18\xdc\x7d\x0d\xe2\x00\x16\x51\x02\xc1\xcf\xf9\x32\x59\xc2\xa0\xd6\x3f\x83\xd6\x50\xc1\x1e\x28\x10\x83" +
+	"\x3d\x97\xd1\xb7\xe6\x12\xef\x45\x06\x9f\x00\x2d\x5a\xfd\xf7\x17\xd7\x16\xa3\x2f\xdc\x15\x9c\xb0\xa6\x65\xee\xc5" +
+	"\x45\x25\x93\xef\x2a\xb0\xc2\x86\xc3\x31\x34\x4e\x9c\x3f\xca\x60\x5d\xe6\x48\xc8\x66\x7d\x23\xd3\xd3\x94\x5c\x18" +
+	"\x23\xe4\x83\xc7\x56\x06\x35\x4b\xd1\xb6\xe3\xf8\x4c\x18\xd4\x24\x68\x48\xa7\xfb\x32\x8b\xfc\x30\x58\xce\x82\x81" +
+	"\x75\xd3\xf0\x49\xd2\x1d\x56\xb6\xdc\x77\x72\xf4\xa4\x38\xd5\x8e\x9e\x1c\x3c\x2f\x70\xe7\x19\x95\x0a\xb6\xfd\x55" +
+	"\xe6\x45\x4f\x0e\x3a\xd8\x3d\x0e\x35\xe1\x54\x88\x94\xe9\x4c\x48\x7b\xab\xf7\x39\x3a\xb2\x55\x8c\xe9\x7f\x4b\xd1" +
+	"\xde\x7d\x6f\xff\x00\xe9\xb2\x3e\x0a\x7a\x77\x82\x55\x71\xe2\xf6\xdd\xd2\x54\x39\x1e\x01\x2b\xa1\x29\x21\x8d\x93" +
+	"\xbb\xc3\x86\x53\xf1\xa8\xc8\x3d\x8a\x9c\x1b\x64\x79\x01\x57\xe0\x9e\x4f\xa7\x67\x1e\xfd\xce\x3e\xf6\x9f\x4f\xeb" +
+	"\xea\x5d\xc7\x42\x7a\xeb\x12\x4b\xcd\xfb\xac\x0a\x69\x6d\x36\x84\xbf\xb3\xf6\x99\x8e\xec\x65\x30\xfc\x67\x4f\x95" +
+	"\xc2\x8a\xa6\x5a\x66\x2f\xc1\x9c\x46\x1a\x5d\xdf\x6a\x30\xd2\x50\x6e\xae\x72\x97\x73\xca\x4d\x2e\xe4\x8e\x33\x0d" +
+	"\xf3\x25\x2c\x6e\xc3\x10\xa2\xb8\x5d\xba\x82\xaa\x69\x76\x85\xbd\xbe\x71\x5c\x33\xbb\xeb\x5d\x5a\x6f\xa0\x83\xa1" +
+	"\x4b\xe7\x3a\x8e\xbe\x77\x26\x6c\xdf\x7c\xbb\x74\x7e\x03\xa2\x28\xc9\xd7\x7d\x06\x00\x00")
+
+func bindataDbQualitysqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataDbQualitysql,
+		"db/quality.sql",
+	)
+}
+
+
+
+func bindataDbQualitysql() (*asset, error) {
+	bytes, err := bindataDbQualitysqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "db/quality.sql",
+		size: 1661,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792370386, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataDbQuerycohorttoptenbyyearsql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x53\x4d\x73\x9b\x30\x10\xbd\xf3\x2b\xf6\x66\xc8\x30\x4d\xd2\x63\xda" +
+	"\x1c\x1c\x9b\x26\x74\x5c\xc8\x00\x6e\x26\x27\x46\x86\x0d\x56\x62\x4b\x54\x92\xc7\xe1\xdf\x87\x15\x1f\xb6\xd3\xa4" +
+	"\x33\xe5\x62\xd8\x7d\xef\xed\xdb\x27\xf9\xfc\x6c\x8e\x4f\x5c\x20\x98\x35\xc2\x9f\x1d\xaa\x06\x76\x1a\x4b\x78\x92" +
+	"\x0a\xf0\xb5\x96\xca\x70\x51\x01\x6b\x7f\x8a\x0d\x6a\x68\x90\x6d\x1a\x30\xb2\x06\x83\x42\x03\xdb\xca\xb6\x4b\xd4" +
+	"\x11\x51\x28\x64\xa6\x15\xe0\xc2\xd6\x35\xdb\x22\xb1\xd4\xd9\xb9\xf3\x10\x66\x77\xa0\x98\x78\xc1\x72\x84\x4f\x53" +
+	"\x70\x1d\x68\x9f\x34\x58\x04\xb3\xcc\x42\x7d\x30\x4d\x8d\x3e\xd4\xac\xc2\xbc\x90\xeb\xd6\x04\xe1\xba\xb7\xbe\xcc" +
+	"\x4b\x1f\xf6\xc8\xab\xb5\xf1\x2d\x5d\xc9\x7d\x2e\x76\xdb\x15\x2a\xd7\x83\xf8\x77\x90\x80\x7b\x3f\x4d\xb2\x30\x0b" +
+	"\xe3\x08\x6e\x1e\x7b\xc5\x4e\xfd\x58\x37\x4e\xe6\x2d\xb6\x05\x74\x62\x30\x0f\xd2\xd9\x38\xc2\xa3\xb1\x64\xd8\x8e" +
+	"\xf8\x91\xc4\xbf\x60\xff\x55\x7e\xe1\xa2\xe4\x05\xea\x55\x43\x72\xf0\x33\x0e\x23\x5b\x26\x92\x86\x1a\x96\x69\x18" +
+	"\xdd\x82\x3b\x68\x58\xee\xc3\x5d\x90\x04\x50\x5b\x4c\x4e\x5e\xe0\x1a\x26\x7d\x08\x93\xab\x2b\xa2\x6f\x1b\x6a\xda" +
+	"\xde\x34\x9a\x9f\x98\x0c\x53\x88\xe2\x0c\xa2\xe5\x62\xd1\xf5\x50\x71\x59\x92\xc4\xc4\xf1\x7c\x3a\x8e\xcb\x8b\x7f" +
+	"\x26\x39\x44\xc7\x94\x62\x4d\xce\xaa\xca\x9d\x4d\xd3\xcc\x75\x7b\x43\x14\xe6\xe0\x8d\x9b\x0d\x8e\x5f\x6c\xa5\x8d" +
+	"\x62\x85\xe9\x0a\x0a\x85\x39\xc1\x76\xa7\xd4\x7d\xd8\x73\xe7\x52\xd0\x58\x9b\xdb\x10\x89\x77\xc8\x98\xa2\xb4\x3d" +
+	"\x1b\xd5\x21\xd4\x77\x77\xe2\x3f\x12\x25\x26\x7c\xbf\x86\xcb\x0b\x5b\xbc\x4d\xe2\xe5\x3d\x4d\xfa\x7b\x79\x0a\x8a" +
+	"\xaa\xcf\x5a\x8a\x4f\xb2\x1a\x52\xa2\xbb\x64\x64\x4e\xc8\x3e\xa7\xd3\xfe\xfb\x14\x0f\xb7\x55\x8f\x9b\xb7\x77\x04" +
+	"\x5f\xc9\x5d\xfb\xf7\x39\x4a\x80\xa0\xde\x08\x62\x42\xec\xd8\xa6\x93\xb5\x04\xd4\x03\xc5\x62\xc8\xc1\x21\x25\x7b" +
+	"\xcc\x1f\xad\x39\x2c\x38\x6c\x63\x69\x96\x32\x2c\xec\x8c\x06\x8e\x19\xdf\x9c\x37\xc1\x13\x26\xa5\xfe\x03\x00\x00")
+
+func bindataDbQuerycohorttoptenbyyearsqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataDbQuerycohorttoptenbyyearsql,
+		"db/query-cohorttoptenbyyear.sql",
+	)
+}
+
+
+
+func bindataDbQuerycohorttoptenbyyearsql() (*asset, error) {
+	bytes, err := bindataDbQuerycohorttoptenbyyearsqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "db/query-cohorttoptenbyyear.sql",
+		size: 1022,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -244,31 +444,22 @@ func bindataDbIndicessql() (*asset, error) {
 }
 
 var _bindataDbQuerypagessql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x5d\x6f\x9b\x30\x14\x7d\xe7\x57\xdc\xb7\x42\xc7\xda\xb5\x8f\x9d" +
-	"\xf6\xc0\x12\xb7\x65\xca\x42\x05\x74\x55\x55\x55\xc8\x05\x97\xba\x4b\x0c\x33\x8e\xd2\xfc\xfb\xe9\xda\x10\x3e\x02" +
-	"\x55\x35\x69\x6f\x09\xf7\xdc\xeb\x73\xee\x39\x98\xd3\xe3\x39\x7b\xe6\x82\x81\x7a\x61\xf0\x67\xc3\xe4\x0e\x36\x15" +
-	"\xcb\xe0\xb9\x90\xc0\xde\xca\x42\x2a\x2e\x72\xe0\xe2\xb9\x90\x6b\xaa\x78\x21\x2a\x5d\xa2\x52\xf1\x74\xc5\x2a\x17" +
-	"\x54\x51\xf2\xb4\x02\x2a\x32\xc8\x57\xc5\x13\x5d\x1d\x9f\x5a\x77\x7e\x7c\xdd\x14\xbc\x08\x6c\x0b\x00\x20\x22\x0b" +
-	"\x32\x8b\xa1\xa4\x39\x4b\x78\x86\xcf\x35\x22\xe1\x99\x2e\x5f\x86\xc1\x4f\xd8\x9e\x17\x27\x08\xa8\xf4\xa3\xbb\x6b" +
-	"\x12\x12\xd3\xa0\x76\x25\x83\x6f\x70\xa4\x5b\x8e\x2e\x2e\x10\xb8\xde\x61\x09\x2b\x96\xe3\x42\xc9\x64\xca\x84\xe2" +
-	"\x2b\x96\x71\x91\xf1\x94\x1d\x1e\x8d\x50\xb7\x21\xe0\xc2\x8e\x51\xe9\xc2\x96\xf1\xfc\x45\xb9\x1a\x57\xcf\x48\x24" +
-	"\x15\xbf\x6d\x07\x82\x5f\x24\x84\x2d\x8e\x69\x87\x1b\xa0\x9d\x31\x51\xb1\x01\xee\x33\x9c\x9d\x7c\x71\x4e\xaf\x42" +
-	"\xe2\xc5\x24\x8a\xed\x31\x50\x06\x9f\x60\xbc\xf7\xdc\x71\xcf\x1c\x3c\xcb\x94\x87\x27\x0e\xa6\x78\x91\x7e\x32\xc9" +
-	"\x46\x7d\x88\x8e\x9a\xe0\xa3\x7a\x84\x8c\x4d\xe3\xb4\xc6\x36\xa6\xb6\x6d\xd7\x7b\x32\xd4\xb6\x93\x02\x2c\xf4\x73" +
-	"\x50\xbb\xf8\xb4\x43\x9f\x4c\x1e\xfc\xe5\x3c\xb8\x33\x8e\xd8\x37\x5e\x18\xfb\xb1\x1f\x2c\xe1\xfb\x7d\xed\xac\x31" +
-	"\xb4\xcd\x4b\x10\xce\x49\x88\x65\xe3\xb1\x63\x28\x98\x53\xff\xa1\x1f\xe6\x24\x9a\xd5\x43\xd4\x47\x49\xb8\xfb\x94" +
-	"\x4f\xd0\x51\x1f\xe6\x33\x3d\xca\x30\x1b\x7f\x0b\x68\x9e\x4f\xbd\x83\xae\x39\x87\x4a\x49\x77\x09\xcd\x73\x7b\xe6" +
-	"\x61\x4e\xea\x37\xa2\x1b\xfa\xc3\x50\x9a\xf8\x1d\xba\x3c\x95\x96\x8e\xcd\x46\x98\x8e\x16\xfa\x8c\x7f\xd6\x8c\x56" +
-	"\x1b\xc9\xd6\x4c\x28\xa7\x15\x87\x15\xf0\xa2\x99\x86\x76\x20\x55\x1b\x94\x43\xbd\xba\x76\x15\x06\xb7\x37\x38\xa2" +
-	"\xa7\x74\x72\x41\xef\xee\x68\xb8\x1e\x63\x45\x97\xce\x5e\x0a\x17\x19\x7b\xc3\xfa\x79\xbf\xbc\x57\xa4\x63\xd5\x28" +
-	"\xaa\x14\x7d\x5f\x0a\xcd\xf3\x51\x35\x96\xd3\x90\x94\xc5\x36\x51\x45\xf2\x5a\x15\xa2\x66\xa7\x1b\xcc\xcf\xbd\x02" +
-	"\x93\x20\xae\x56\xcd\xed\x47\x9f\x2a\x25\x69\x8a\x1e\x53\x89\xaf\x6f\x8b\x6a\xaf\xc8\x54\x32\x7d\xe1\xf7\xcc\xc2" +
-	"\x4a\x1d\xdc\x59\xe0\x2d\x48\x34\x23\xb6\xd6\x61\x52\xf4\xf0\x68\xae\xe6\xf1\x45\x3c\x3c\x1e\xb4\x16\x29\xa7\xab" +
-	"\xd7\xcd\xba\x1c\x0c\xc0\x73\x1e\x1e\x1d\xab\x77\x30\x7e\x85\x1c\xc7\xea\x7f\x29\xa0\x84\x05\xb9\x8c\xe1\x47\xe0" +
-	"\x2f\x61\xe1\xc5\x24\xf4\x16\x7d\x27\x87\x06\xfe\xb7\xbd\xb4\x3e\x0b\x53\xe9\xc8\x6b\x7d\xde\x08\xc1\x2a\x65\x97" +
-	"\xba\x27\xe9\x40\x1c\xd0\xdf\xcd\x20\x9c\xfb\x4b\x6f\xe1\xc7\xf7\x90\xb4\x5c\x71\xa2\x96\xd8\x0a\xbf\x8d\xfc\xe5" +
-	"\x15\x34\x10\xdc\x55\x02\xc1\x12\xe2\xf0\x96\x58\x1a\x3a\x99\xf5\x61\xe7\x9e\x77\x4d\x8a\x67\x5f\xad\xbf\x01\x00" +
-	"\x00\xff\xff\x0b\xa8\x77\x41\x19\x08\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x91\x4b\x6f\xa3\x30\x14\x85\xf7\xfc\x8a\xb3\xc8\x02\x2a\x34\x55\xb3" +
+	"\xec\x3c\x24\x26\x71\xa7\x8c\x18\x90\x80\xaa\xaa\xa2\x0a\xb9\xe0\x52\x57\xc4\x66\x6c\xa3\x34\xff\x7e\xb0\xc9\xab" +
+	"\x8d\xd4\xdd\x78\x65\x1f\x7f\xf7\xdc\xd7\xe5\xc5\x92\x3d\x73\xc1\x60\x5e\x18\xfe\x0e\x4c\x6d\x31\x68\xd6\xe0\x59" +
+	"\x2a\xb0\xb7\x5e\x2a\xc3\x45\x0b\x2e\xc6\xf7\x9a\x1a\x2e\x85\x76\x5f\x74\xd4\xeb\x8e\xe9\x10\x46\xf6\xbc\xd6\xa0" +
+	"\xa2\x41\xdb\xc9\x27\xda\x85\xd8\xbc\x48\xcd\xd0\xd3\x96\x21\x5e\x82\xeb\x31\xdc\xd9\x2b\x2a\x46\x69\x35\xbb\x0a" +
+	"\x67\xf3\xe0\xe2\xd2\x2b\x48\x42\x16\x25\x94\xdc\x54\x46\x56\xaf\x5a\x0a\x7f\x11\x15\xa5\xef\x7b\x18\xcf\x74\xb5" +
+	"\x2e\x15\x6f\x42\x67\x57\x19\x6e\x3a\xb6\xbb\xd3\x27\x6d\x14\xad\x8d\x7d\x2a\x26\xcc\x09\xb5\xed\xf7\x50\xad\x98" +
+	"\xab\x7a\xcb\xa8\x0a\x10\x15\xd8\xcc\xe5\x17\xfb\x13\x84\x53\x92\x2c\x4a\x48\xb1\x20\xbe\x36\xd4\xe8\x90\x2a\x45" +
+	"\xb7\xab\xc7\xeb\x6b\x8b\x71\xd1\xb0\x37\xeb\x35\x5f\x33\xaa\x07\xc5\xd6\x63\x16\xbd\x7a\x3c\x0b\x95\x35\xa7\xdd" +
+	"\xeb\xb0\xee\x3f\x18\xd8\x3c\xe7\x78\xcf\x14\x97\xcd\xa7\xf9\x26\xe4\xf3\xac\x8a\x6e\x6a\x39\x88\x8f\x26\x7b\x79" +
+	"\x0c\xf0\xde\x35\x6c\x57\x18\x04\xde\x4d\x9e\xfd\x39\x68\x1a\x3d\x12\x72\x53\xe2\x77\x16\xa7\x48\xa2\x92\xe4\x51" +
+	"\x82\x69\xfc\xbb\xe5\x38\xf3\x8a\xb6\xad\xff\x9f\xf7\x81\x2c\x5f\x92\x1c\x3f\x1f\x20\xa6\x9f\x93\xb1\xba\x82\x5c" +
+	"\xe5\x83\x10\x4c\x1b\xbf\x77\x31\xd5\x09\x12\xe0\x3e\x2e\x6f\xad\x49\x9c\x46\x49\x5c\x3e\xa0\x3a\xd6\x6a\x1d\x5d" +
+	"\x8b\xc7\xc6\xef\x8a\x38\xfd\x85\x3d\x62\x67\x55\x21\x4b\x51\xe6\x77\xc4\x7b\x8f\xda\x45\x9d\xe1\xc7\xa9\xed\xb9" +
+	"\xc3\x3e\xce\xd8\xfb\x5b\x92\x13\xec\x4a\xe6\x0d\x7e\x7c\xc7\xec\x0a\x51\xba\x3c\xd1\xbe\x61\x36\xf7\x0e\x13\x38" +
+	"\xe8\x5f\xbd\x7f\x62\x27\x77\x18\xa0\x03\x00\x00")
 
 func bindataDbQuerypagessqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -287,10 +478,10 @@ func bindataDbQuerypagessql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/query-pages.sql",
-		size: 2073,
+		size: 928,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -299,25 +490,26 @@ func bindataDbQuerypagessql() (*asset, error) {
 }
 
 var _bindataDbQuerytoptenbyyearsql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xd1\x6e\xda\x30\x14\x7d\xe7\x2b\xee\x5b\x43\x15\x51\xba\xc7\x4e" +
-	"\x3c\x64\x90\xb5\x99\xd2\x30\x25\x41\xd5\x9e\x22\x43\x6e\x53\x6f\x60\x67\xb6\x11\xe4\xef\x27\x5f\x07\xd3\x14\x34" +
-	"\xf5\x29\xf1\xb9\xc7\x3e\xd7\xe7\x1e\xdf\xdd\x2e\xf0\x95\x0b\x04\xf3\x86\xf0\x77\x8f\xaa\x83\xbd\xc6\x1a\x5e\xa5" +
-	"\x02\x3c\xb6\x52\x19\x2e\x1a\x60\xca\xf0\xcd\x16\x35\x74\xc8\xb6\x1d\x18\xd9\x82\x41\xa1\x6f\xef\x46\x2f\x49\xf9" +
-	"\x64\x51\xa5\x21\x2a\x20\x18\x01\x00\x14\x71\x1a\xcf\x4b\x42\x69\xfd\x3d\x5f\x3e\xc3\xe1\x8b\x9c\x18\xbe\xc3\xb5" +
-	"\xdc\x8b\x5a\x87\xd0\xa0\x40\xc5\x0c\x56\x1a\x15\x47\x1d\xec\xb8\xb0\x1b\xc2\x1d\x3b\xda\xef\x18\xaa\x80\xbe\x74" +
-	"\xc2\x2a\x4b\x96\x19\x44\x69\xfa\xfe\xfc\xa9\x55\x24\x91\x71\x68\x5b\xe2\x9b\xcb\x1e\x5a\xd6\x60\xc5\x6b\x8b\x13" +
-	"\xa3\xe2\xf5\xb0\x25\x4b\xd0\x04\xbd\x3c\xc5\x79\xec\x36\x98\xae\x45\x98\xc1\x0d\x6d\xb9\x79\x78\xb0\xc4\x5d\x67" +
-	"\x4b\xb6\x42\x72\x5d\x8b\x97\x6a\x8b\xa4\x28\x93\x6c\x5e\x52\x79\xa8\xc3\x45\xcd\x37\xa8\xd7\x9d\x77\xe5\x8a\x5e" +
-	"\x6f\xf3\x75\x45\xd9\xde\x4f\x2f\x14\xab\x09\x99\x06\xd5\xc4\xf2\x42\x60\x4a\xb1\xae\x62\x4d\x13\xcc\xa3\xa2\x0c" +
-	"\x82\x76\xd2\x3b\x10\x42\xff\x6b\xb8\xd9\xa2\x5f\xb1\xb5\x36\x8a\x6d\x8c\x03\x14\x0a\x33\xe0\xd2\x99\xfd\x62\xa3" +
-	"\x90\x19\x2e\x85\x9b\x4e\x54\x78\xfb\xc6\xb0\xcc\x17\x71\x0e\xdf\x7e\xc1\x01\x79\xf3\x66\x60\x11\x17\x73\xa2\x90" +
-	"\xbb\x70\x76\x82\x82\x72\x1a\x56\xef\x62\x48\xe5\x34\x2a\xe3\x3c\x4a\xfb\xdb\x7d\x48\x91\x63\x86\xe0\xef\xe2\x74" +
-	"\x3c\xf5\x3f\x2e\x9f\x9d\xb6\x10\xcc\x5c\x0b\xe4\x1a\x44\xd9\xc2\xa7\x02\x66\x7d\x57\x13\x8f\x50\xd9\x4d\x86\x1a" +
-	"\x25\x8b\x09\xfd\xdc\xd0\x4e\xf2\xd7\xdc\xf1\xc5\x34\x79\x4e\x4a\xb8\x9f\x12\x30\x86\x0a\x7e\x2c\x93\xec\x9c\x4c" +
-	"\x68\x61\x55\x24\xd9\x23\x04\xfd\xdd\xdd\x7b\x78\xcc\x97\xab\x9f\xf6\xc8\xe1\xfc\x6d\x4e\xec\xfa\xb7\x96\xe2\xea" +
-	"\x73\x0c\x41\xc9\x43\x65\x64\x65\x19\x7d\x44\x1c\xfe\x31\x38\x67\xc3\xb5\x1f\x36\x17\x35\x1e\x15\x13\x7f\xb8\x68" +
-	"\xde\x0d\xdd\x52\xc7\x9e\xc4\x84\xd8\xb3\x2d\x51\x51\x9f\xc8\x54\xb5\x9a\xe7\x28\x50\x9e\x87\x97\x71\x6f\xf9\xd4" +
-	"\x2f\xd1\x7d\x6a\x68\xe5\x25\x2d\xf2\x75\xf4\x2f\x00\x00\xff\xff\xb3\xc1\x4a\xa4\xbc\x04\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x54\xc1\x72\xda\x30\x10\xbd\xe7\x2b\xf6\x86\xc9\x78\x08\xe9\x31\x9d" +
+	"\x1e\x5c\x70\x13\x77\x1c\xd3\xb1\xcd\x64\x7a\xf2\x08\xd8\x38\x6a\x41\x72\x25\x31\xe0\xbf\xaf\xb4\xb2\x4d\x08\x4c" +
+	"\xa7\x5c\xe4\x7d\xfb\xa4\x5d\xbd\x7d\xe2\xee\x76\x8e\xaf\x5c\x20\x98\x37\x84\x3f\x7b\x54\x2d\xec\x35\x6e\xe0\x55" +
+	"\x2a\xc0\x63\x23\x95\xe1\xa2\x06\x66\x97\xf5\x16\x35\xb4\xc8\xb6\x2d\x18\xd9\x80\x41\xa1\x6f\xef\x6e\x5e\x92\xf2" +
+	"\xc9\xa1\x4a\x43\x54\x40\x70\x03\xf6\x57\xc4\x69\x3c\x2b\x09\xa5\xf8\x5b\xbe\x78\x86\xc3\x27\x39\x31\x7c\x87\x2b" +
+	"\xb9\x17\x1b\x1d\x42\x8d\x02\x15\x33\x58\x69\x54\x1c\x75\xb0\xe3\xc2\x6d\x08\x77\xec\xe8\xd6\x31\x54\x01\xad\x74" +
+	"\xc2\x32\x4b\x16\x19\x44\x69\xfa\xfe\xfc\xa9\xab\x48\x45\xc6\xa1\x6b\x89\xaf\x2f\x7b\x68\x58\x8d\x15\xdf\x38\x9c" +
+	"\x18\xf6\xfb\xbc\x25\x47\xd0\x04\xbd\x3c\xc5\x79\xec\x37\x98\xb6\x41\xf8\x02\x23\xda\x32\x7a\x78\x70\xc4\x5d\xeb" +
+	"\x52\x2e\x43\xe5\xec\x7a\x59\x6d\x9e\x14\x65\x92\xd9\x0f\xa2\x9d\xd5\xe1\x62\xc3\xd7\xa8\x57\xed\xa0\xca\x95\x7a" +
+	"\x9d\xcc\xd7\x2b\xca\xe6\x7e\x7a\x51\xb1\x9a\x90\x68\x76\x75\xbc\xd0\x0e\x4a\xb1\xb6\x62\x75\x1d\xcc\xa2\xa2\x0c" +
+	"\x82\x66\xd2\x29\x10\x42\xf7\x69\xb8\xd9\xe2\x10\xb1\x95\x36\x8a\xad\x8d\x07\x14\x0a\x73\xc6\xa5\x33\xbb\x60\xad" +
+	"\x90\x19\x2e\x85\x9f\x8e\x6d\xa4\x97\x6f\x0c\x8b\x7c\x1e\xe7\xf0\xf5\x27\x1c\x90\xd7\x6f\x06\xe6\x71\x31\x23\x0a" +
+	"\xa9\x0b\x27\x25\xc8\x28\xfd\xb0\x3a\x15\x43\x4a\xa7\x51\x19\xe7\x51\xda\xdd\xee\x83\x8b\x3c\x33\x84\xe1\x2e\xbe" +
+	"\xce\x40\xfd\x87\xca\x27\xa5\x1d\x64\x45\xa6\x16\x48\x35\x88\xb2\x39\x34\xd6\x7d\x72\xe3\xc4\x1f\x51\xdc\xbb\xc4" +
+	"\x22\xbe\xcb\xc9\x80\x50\xda\x4f\x8a\x1a\x27\xc9\xfd\x21\xff\x35\xc4\xbe\x9d\x6b\x6a\x0d\xc9\x34\x79\x4e\x4a\xb8" +
+	"\x9f\x12\x60\x1f\x01\x7c\x5f\x24\xd9\xc9\xa9\xd0\xc0\xb2\x48\xb2\x47\x08\x3a\x2d\xfc\xfb\x78\xcc\x17\xcb\x1f\xee" +
+	"\xc8\x73\x3f\x38\xdf\xb8\xf8\x97\x96\xe2\xea\xf3\x0c\x41\xc9\x43\x65\x64\xe5\x18\x9d\x65\x3c\xfe\xd1\x48\xa7\x01" +
+	"\xe8\x61\xf8\x56\x6d\x3c\x2a\x26\x7e\xdb\xbf\x88\x77\x26\x70\xd4\xf1\x40\x62\x42\xec\xd9\x96\xa8\xa8\x7b\x32\x65" +
+	"\x5d\xcd\x93\x35\xc8\xdf\xe7\x97\xf1\x6f\xbb\xef\x97\xe8\x83\x8b\x28\x1a\x4a\x3a\xe4\xf3\xcd\x5f\xc1\x9f\x26\x3b" +
+	"\xcc\x04\x00\x00")
 
 func bindataDbQuerytoptenbyyearsqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -336,10 +528,54 @@ func bindataDbQuerytoptenbyyearsql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/query-toptenbyyear.sql",
-		size: 1212,
+		size: 1228,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataDbStatssql = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x52\x5d\x4f\x83\x30\x14\x7d\xe7\x57\xdc\x37\x60\x21\xce\xf8\x38\xa3" +
+	"\x09\x6e\x2c\x92\x20\x24\xc0\xe2\xe3\x52\x68\xe7\x6a\x80\x36\xb4\xb8\xf0\xef\x6d\xbb\x85\x81\x3a\x9d\x3c\x95\x7b" +
+	"\x7b\x3e\xee\xb9\x9d\xcf\x56\x48\x22\x21\x91\x14\x50\xb2\x46\x22\xda\x08\x90\x7b\x02\xba\x44\x85\xa4\xa5\x00\xb6" +
+	"\x33\x15\x5a\x73\xd6\x4a\x82\x01\x2b\x84\x07\x54\x42\xdd\x09\x09\x05\x51\xc0\x9a\x77\xba\x53\x90\x1d\x6b\x89\xb9" +
+	"\xdd\x92\x0f\x2a\x28\xd3\x6c\xa8\xa8\x14\x5a\x00\x6e\x19\xe7\x04\xcf\xe6\xd6\x32\x0d\xfc\x3c\x80\xdc\x7f\x8a\x02" +
+	"\x38\xdc\xb1\x1b\x3c\x98\xf0\x33\x2b\x0b\xa2\x60\x99\x03\x6a\x95\x7a\x45\x84\x07\x92\x71\xe5\xc3\x1b\x2a\x07\x2a" +
+	"\xf7\x82\x95\x14\x55\xef\x5d\xcd\x55\x63\x10\xf3\xa0\x60\x72\xfa\xa7\x71\x0d\x6b\xfa\x9a\x75\x62\xd4\xe9\x04\x69" +
+	"\x85\xb5\x4e\x93\x17\x70\x2c\x50\xdf\x49\x75\x99\x6c\xe2\xdc\x99\xb9\xb0\x0e\xa3\x3c\x48\xc1\x79\x7d\x0e\xd2\x00" +
+	"\x38\x7a\x23\x5b\xd9\x73\x02\x0f\x60\x9f\x7c\xd8\x8b\x85\xf6\x5e\xf7\xba\xa9\x7b\xae\x72\x7f\xb6\x6d\x58\xaf\xa1" +
+	"\x33\xe3\xfd\x4c\x76\x9a\xfc\x6a\xaa\x4b\xce\xc0\x8f\x57\x50\xa2\x16\xd3\x06\x55\x54\xf6\x8e\x41\x8d\x32\x74\xe1" +
+	"\x11\x6e\x27\xfe\xbf\x84\x6c\x2c\x98\xb8\x34\xb3\x86\x0b\xcb\x05\xee\x5d\x48\x4f\x11\x9d\xc3\xfe\xcd\xbe\xde\xc3" +
+	"\x96\x0a\xb5\x28\x03\x9a\xac\x6f\x84\x5b\x85\x59\x1e\xc6\x4a\xe1\x78\x1f\xff\x4d\x73\x8d\x2c\x86\x30\x83\x78\x13" +
+	"\x45\xc7\xc9\xbf\x3f\x93\x7f\x38\x88\x93\x7c\xe4\x02\x92\x14\x16\xb6\x3a\x70\x56\xd1\xb2\xb7\xf5\x72\x68\x53\x56" +
+	"\x1d\x26\xb6\x11\x3b\xbe\xbf\x49\xa8\x83\xac\x0a\xb6\xbd\xb7\x3e\x01\x01\x5a\x47\x3e\x9b\x03\x00\x00")
+
+func bindataDbStatssqlBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataDbStatssql,
+		"db/stats.sql",
+	)
+}
+
+
+
+func bindataDbStatssql() (*asset, error) {
+	bytes, err := bindataDbStatssqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "db/stats.sql",
+		size: 923,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792368040, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -348,12 +584,35 @@ func bindataDbQuerytoptenbyyearsql() (*asset, error) {
 }
 
 var _bindataDbTestsql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\xb1\x0e\xc3\x20\x0c\x44\xf7\x7c\x85\x67\xa4\x96\x0f\xe8\xda\xb1" +
-	"\x63\xc7\x2c\xa6\x1c\x91\xa5\x08\x08\x76\x07\xfe\xbe\xa2\x49\x96\x6c\x3e\xf9\xdd\x3d\xef\x5e\x85\x23\x45\x36\x0e" +
-	"\xac\x70\x7e\x9a\x85\xc6\x75\xd7\x6d\x7d\x8c\x20\x39\xca\x07\xba\xe7\xc9\xbb\xa7\x28\x87\x15\x54\x79\x41\xa3\x54" +
-	"\x1a\x19\xd4\x24\x2f\xb4\x7d\xd1\x04\x3a\x36\xaa\xc2\x0e\xa2\xa4\x34\x6a\x6f\xa8\x9d\x04\x95\x7c\x31\x5a\xaf\xa7" +
-	"\x62\x96\x3f\xd6\x6f\x56\xaa\x21\x87\xde\xc1\xed\xf2\x1a\xcb\x3b\xfe\x0b\x00\x00\xff\xff\xc4\x27\x5b\x29\xc0\x00" +
-	"\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x55\xc1\x52\xdb\x30\x10\xbd\xe7\x2b\xf6\x16\xc2\x84\x00\x2d\x53\x5a" +
+	"\x33\x1c\x52\xf0\x50\x66\x18\x86\x21\xe9\xb4\x07\x0e\x28\xf6\x3a\xd6\xe0\x48\x46\x92\x13\xd2\xaf\xef\xca\x72\x6c" +
+	"\xd9\x0e\xb9\x69\x77\x9f\xf7\xed\xbe\x95\xd6\xa7\xc7\x53\xc1\xb2\xad\xe6\x1a\x36\x5c\xc4\x72\x03\x74\x2a\xc4\x42" +
+	"\x16\x22\xc6\x78\x4c\xc7\x0c\xb5\x06\x9d\x63\xc4\x13\x8e\x31\xa1\x4c\x0a\x27\xeb\x0a\x9d\x28\xb9\xba\x9e\x4c\x26" +
+	"\x8d\xc7\x48\x6b\x1f\x9f\x0e\x4e\x29\x33\x7d\xae\xd1\xc0\x9a\x29\xce\x16\x19\xc2\x1b\x62\xae\x81\x1b\x0d\x72\x23" +
+	"\x40\xb0\x15\x8e\x01\x27\xcb\x09\x04\x4d\xba\x31\x6c\x52\x1e\xa5\xb6\x0e\x85\x79\xc6\x22\x22\x5d\x6c\xc1\xa4\x08" +
+	"\x31\x26\xac\xc8\x0c\x1c\xe5\xfa\x3d\x83\x1f\x90\x32\x0d\x42\xc2\x0b\x4f\x46\x44\xf8\x62\xa9\x9a\x3c\x7e\x4e\x3f" +
+	"\x66\xe4\x2e\x62\xe4\x60\x16\x3e\x84\x37\x73\xb8\x99\xce\x42\xf8\xf3\x2b\x7c\x84\x60\xd8\x7c\x35\x84\x6b\x18\x06" +
+	"\xbe\x3d\xb7\x90\xe1\x10\xc2\x07\xc2\xb7\xa1\xe1\xe3\x2d\x4c\x67\x1e\xff\x78\xd0\xcf\x6a\xa4\x9f\xd3\x5a\x7b\x33" +
+	"\xda\x40\x2b\x1f\xd5\xfc\xb2\xa4\x16\x48\xd4\x3b\xc5\x44\x91\x91\xa0\x66\x0b\x32\x29\x65\xc9\x51\x71\x19\x83\x36" +
+	"\xcc\x70\x6d\x78\xa4\xad\x76\x5b\x64\x0a\x8e\x84\x14\x38\x1a\xc3\x7b\xc1\x94\x41\x05\x52\xc1\x4a\x0a\x93\x1e\x98" +
+	"\xeb\xb2\xc9\x5f\x0d\xb2\xd4\xce\x73\x43\xe0\x19\xfb\x14\xf4\xc2\xae\xdd\x96\xc3\x75\x6c\xcb\xab\xbb\x6e\xc5\xab" +
+	"\xc6\x7d\xc2\x5d\xef\x3f\x25\xdd\x1c\xa6\x10\xf0\x23\xca\x0a\xba\x9f\x50\x0e\xda\x6a\x40\x32\xf1\x08\xf5\x81\xc6" +
+	"\x16\xd2\xe4\x32\xe3\x51\xab\xad\xda\x09\x41\x7d\xdc\xd7\x52\x1d\x74\x0d\x79\xa6\x6b\xa7\x2a\xa8\xee\xc8\x03\x54" +
+	"\xfd\x34\x4c\xbb\x6e\x9e\x64\x86\x2b\x1e\x01\x35\xfa\xa6\x01\xd7\xa8\xb6\xd4\x1c\xcd\x8f\x1e\x4a\x59\x74\x52\x64" +
+	"\x19\x44\x52\x24\x3c\x46\x11\xe1\x81\xde\x72\x97\x6a\xc5\x45\xa1\x51\xe9\xdd\x8b\x6c\xdc\x0a\xd7\x5c\x73\x29\xba" +
+	"\xa1\x9c\x6e\x8e\xf2\x05\xe9\x64\x82\xa0\xe3\xe8\xa2\xea\xc4\x3e\xb2\x76\xb6\xd0\x25\x57\x0d\x2b\xad\x7d\x52\x77" +
+	"\x08\x9d\xe0\x3d\xa7\x93\xfd\xac\x16\xbc\x07\xa8\x64\xef\xf8\xdb\x8f\x72\x4f\xc5\x5d\x3a\x2f\xf0\x39\xa5\x07\xea" +
+	"\xd1\xd6\xb1\xbd\xd4\xa5\x0a\x2d\xce\xca\xf3\x09\x59\x15\x6d\xb3\x38\x61\x77\xd7\x6a\x96\xda\x17\x52\xbd\x07\x10" +
+	"\x48\xd7\xe4\xfc\x0c\x9a\x39\x11\x34\xe1\x4a\x1b\xc0\xb8\x5c\xc4\x09\x30\x51\xdf\x3b\x4e\xc7\x72\x75\x1c\xb8\x6c" +
+	"\xda\x12\x50\x67\x2c\x32\x7c\xdd\x59\x13\xdd\x18\x04\x5d\xcf\xbe\x91\x77\x31\x4e\x90\xbe\xd7\x89\x72\xde\xa8\xd2" +
+	"\x87\x54\xca\xf4\xea\x70\xea\x90\x3c\x0f\x92\xc5\x10\x33\xc3\x16\x4c\xa3\x2d\x9b\x83\x3d\x4d\xe8\x8f\x72\x65\x8d" +
+	"\x4a\xb8\xda\xb6\x5b\xb5\xb1\x68\x91\x66\x94\xce\xd9\x94\xed\x96\xeb\xf2\xc7\x96\xb3\x25\xad\xd7\x84\xb4\x35\x48" +
+	"\x2b\x58\x2c\x09\x49\x5b\x19\xb5\x65\xc8\xcb\x47\x50\x22\x64\x92\xd8\xcf\xe6\x04\xda\x21\x40\x8a\x4e\x3d\x66\x9b" +
+	"\xa3\x4f\x49\x8b\xe1\xc4\xc8\xdc\xa0\x58\x6c\xed\x6c\x3a\xa1\x48\xa6\x52\x99\x3e\x80\x68\x52\x57\x99\x76\x48\xfb" +
+	"\x5b\xc8\x99\xa2\xbf\xae\x51\xfc\x1f\x4d\x54\xd2\xce\xa1\x79\x5b\x08\xdc\xdf\xda\x45\xb4\xa4\x2d\x63\x3b\xa0\x7f" +
+	"\xb4\x8b\xba\xb5\x64\x11\xbb\x11\x97\xa9\x5c\xd6\xd7\x88\x55\xf6\x49\xe9\xb0\xbc\xaf\x83\xa7\xe7\xf0\x69\xfa\x1c" +
+	"\x7a\xc0\xa3\xfb\xc7\x79\x78\x17\x3e\x8f\xa1\x3a\x8c\xec\x8c\x82\x06\x30\x08\xff\x86\x37\xbf\xe7\xad\x6f\xce\xc6" +
+	"\xf0\xe5\xfc\xe2\xf2\xe2\xfb\xd7\x6f\x17\x97\xa3\xab\xff\x98\x7c\x03\x8a\xa9\x08\x00\x00")
 
 func bindataDbTestsqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -372,10 +631,10 @@ func bindataDbTestsql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/test.sql",
-		size: 192,
+		size: 2217,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -384,26 +643,69 @@ func bindataDbTestsql() (*asset, error) {
 }
 
 var _bindataDbTypessql = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x4f\x6f\xda\x4c\x10\xc6\xef\xfe\x14\x73\x04\x64\xbd\xe8\x45\xea" +
-	"\x89\x93\x8b\x9d\x96\xca\x05\x64\xdc\x28\x34\x8a\xa2\xc5\x0c\xb0\x62\x99\x75\x77\xd7\xa1\xfe\xf6\xd5\xae\x49\xf0" +
-	"\x1f\x40\x2d\x27\xc0\xcf\x3c\xbf\x99\x67\x06\x86\x83\x07\x85\x08\x3a\x67\x19\x82\xe6\x94\x21\x28\x7c\xe3\x9a\x4b" +
-	"\xd2\x60\xd8\x5a\x20\x9c\xb8\x10\x40\xd2\xc0\x1a\x81\x51\x79\x94\x0a\xa1\xd0\xb8\x2d\x04\x30\xda\x00\xa7\x0d\xfe" +
-	"\x46\x5d\xc9\x0c\x3b\x20\x30\x10\xd2\x80\xdc\x56\xae\x83\xa1\x17\x26\xf3\x05\xa4\xc1\xe7\x38\x82\xd3\x48\xfe\xf7" +
-	"\x01\x18\x7b\xde\x70\x10\xe2\x96\x13\x7e\xd8\xc8\x37\x54\xf6\x03\xcf\x50\xaf\xcb\x12\x99\x1a\x0c\xbd\x49\x12\x05" +
-	"\x69\x04\xd3\x59\x18\x3d\xc1\x7c\xe6\x5c\x1a\x1a\xe8\xe5\x6c\x87\xaf\x7c\xd3\x1f\x7b\xc3\xc1\x0f\x8d\x1b\x58\x97" +
-	"\x10\x07\x69\x94\x04\x31\x7c\x9b\x4f\x67\xc0\x09\x7e\x15\xa8\x38\xea\xbf\x33\x3c\x21\xdf\xed\x0d\x84\xd1\x72\xe2" +
-	"\x83\xfd\xca\x07\x23\x73\x9e\xbd\xf2\x8d\x0f\xa6\xcc\xd1\x07\xc7\xb4\x6f\xfb\x63\x2f\x98\x05\xf1\xea\x67\xd4\x75" +
-	"\x1a\x7b\x76\xca\x74\x8f\xb0\x95\x42\xc8\x13\xa7\x9d\x2b\xd7\xc0\xaa\x20\x5d\xab\x66\x8f\xce\x4e\xbb\x4c\x8d\xcc" +
-	"\x0d\xd2\xb9\x93\x4b\xd7\xef\x6d\xa7\xab\x45\x05\xb2\xcf\x8f\xc8\x74\xa1\xf0\x88\x64\x20\x58\x42\xcf\x03\x00\x78" +
-	"\x64\xa2\x40\x68\xbf\x1e\xe2\x79\x90\xfa\x4e\xb0\x40\x95\x21\x19\x2e\xf0\x86\x20\x44\xd2\xd8\x51\xd5\x04\x09\xa3" +
-	"\x43\x87\x00\xd3\x59\x1a\x7d\x89\x92\x4a\x92\xda\xbc\xee\x79\x38\x41\x87\xd4\x16\x74\x48\x0d\xc8\xca\x66\x74\xab" +
-	"\x0f\xaf\x3f\xee\xa6\xe6\x4e\xcd\xae\x60\x54\xcb\x4e\x5f\xc2\x9b\xda\xe7\x69\x99\x37\x03\xb4\x95\xc7\xd2\xd5\x56" +
-	"\xe0\xef\xf5\xe2\x86\xac\xb5\x96\xe7\x97\xab\x6d\xd8\x75\xd7\xa0\x61\x77\x88\x76\x9c\xdc\x88\xee\x52\x1f\x83\x64" +
-	"\xf2\x35\x48\x7a\x9f\xfe\x1f\xf5\x2b\x5d\xb0\xd6\x46\xb1\xcc\x34\x75\x69\xf4\xf4\xbe\x7b\xa6\x90\x4c\x1b\xd8\x44" +
-	"\xb5\xa7\xaf\x45\x60\xfb\x76\xf7\xef\x94\x13\x85\xcc\x70\x49\xcd\x35\xdc\x8b\xdf\xd6\x73\xda\x4a\xb8\x0c\xbf\xb0" +
-	"\x51\x5c\xc5\x59\x71\x05\x5a\x1a\x56\xcf\xb9\xa6\xb9\xbe\xcf\xe7\x97\xaa\x2e\xe6\x74\xb8\x5e\x67\xbd\x6f\xec\xc6" +
-	"\x59\x2a\x46\x07\xfb\x6b\x6d\x1e\xc6\x8d\x54\x6a\x87\x91\x9c\xeb\xfe\x89\xc8\x88\x0a\x26\xce\xff\x82\x1d\xf2\xdd" +
-	"\x23\xf7\x2f\xcd\xa1\x6e\xc1\xdb\xc3\x54\xf4\x3f\x01\x00\x00\xff\xff\x59\xc2\x56\xa2\xf6\x05\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\xdd\x73\xda\x38\x10\x7f\xf7\x5f\xb1\xf7\x54\x48\x49\x93\x74\xe6" +
+	"\x9e\x32\xf7\xe0\x82\x93\x72\x47\x81\x31\x4e\xd3\xb4\xd3\xc9\x28\x46\x21\x9a\x1a\x9b\xb3\x05\x1c\xf9\xeb\x6f\x25" +
+	"\xf9\x43\x96\x65\x02\x69\x1f\x9b\x99\x64\x82\xbd\xda\xfd\xe9\xb7\x1f\xda\x15\x67\x27\x57\x29\xa5\x90\xad\x48\x88" +
+	"\x7f\x59\x8c\x7f\x53\xba\x61\x19\x4b\xe2\x0c\x38\x79\x88\x28\x6c\x59\x14\x41\x9c\x70\x78\xa0\x40\xe2\xdd\x32\x49" +
+	"\x29\xac\x33\xfa\xb8\x8e\xf0\xe3\x1c\x58\x3c\xa7\xff\xd1\x4c\x89\x71\xf2\x03\x85\x20\x42\xe9\xe4\x51\x69\x3d\x39" +
+	"\x73\x06\xfe\x64\x0a\x81\xfb\x61\xe4\xc1\xf6\x7d\xf2\xae\x34\x70\x69\xbe\xa1\x73\xc6\xb7\x24\x6d\xbe\x88\xe9\x36" +
+	"\x4c\x96\xd4\xf2\x66\x45\x16\x34\x4c\x29\xe1\x4a\xa1\x73\x76\x32\xa0\x8f\x2c\xa6\x25\xae\x64\x43\x53\xf1\x81\x85" +
+	"\x34\x7b\xd8\xed\x28\x49\x11\x51\xdf\xf7\xdc\xc0\x83\xe1\x78\xe0\x7d\x81\xc9\x58\x6a\xaa\xc9\x40\x47\x28\xbe\x67" +
+	"\xf3\xee\x25\xaa\xbc\xc9\xe8\x1c\x1e\x76\x30\xc2\x45\xbe\x3b\x82\xbf\x27\xc3\x31\xea\x84\x7f\xd7\x34\x65\x34\x3b" +
+	"\x4c\xe1\x96\xb2\xc5\x13\x87\x81\x37\xeb\xf7\x40\x3c\xea\xc1\x0a\x97\x27\xf3\x1e\xf0\x64\xc5\x42\x34\x86\xff\xed" +
+	"\x56\x14\x9f\x0b\xdb\xe2\x5f\xb4\xee\x8e\xdd\xd1\xdd\x57\xaf\xa9\x11\x37\x8b\xd0\x82\x27\x0a\x8f\x49\x14\x25\x5b" +
+	"\x16\x2f\xe4\xf2\x0c\x88\xf2\x90\x84\xcc\xf1\xbd\x50\x97\x49\x67\xa1\x21\x4e\xe3\x1c\x51\x85\xbe\x80\x1f\xdc\x4d" +
+	"\x95\x21\xf1\x7e\x49\x49\xb6\x4e\xe9\x92\xc6\x1c\xdc\x19\x74\x1c\xc0\x9f\xcf\x24\x5a\x53\x30\x7f\xae\x46\x13\x37" +
+	"\xe8\x49\x81\x29\x4d\x43\x5c\xc1\x22\xda\x22\x30\xa0\x71\x46\x1b\x52\x9a\x80\x4f\xe2\x1f\x0d\x0b\xc8\x6c\xe0\x5d" +
+	"\x7b\xbe\x12\x09\x04\x5f\xfb\x74\x48\x81\x86\x25\x53\xa0\x61\xa9\x66\xa4\x9f\xc4\x8f\x6c\x4e\x45\x4a\xd8\x77\xf2" +
+	"\x75\x16\x8a\x5c\x68\xe7\x62\x94\x2c\x2c\x32\x26\x8a\x86\x88\x29\xd0\x50\xa3\x09\xf4\x93\xa7\x24\xe5\x26\x15\x0d" +
+	"\x01\x93\x8a\x86\x80\x49\x85\xc1\x84\x10\x31\x71\x36\x74\x98\x38\x35\x81\x3b\x11\x6f\x6d\x3e\x75\x30\xca\x1b\x11" +
+	"\x28\xd3\x57\x84\xf3\x7b\x2d\x0e\xb3\x2a\x10\x87\xe2\x7d\x80\xef\x6b\x1a\xc5\xca\xe5\x4e\xae\x55\x86\x3f\xe9\x8b" +
+	"\x6b\x62\x46\x88\x7f\xfb\x6e\x85\x21\x52\x47\x33\x3a\x00\x78\x29\x34\x19\x8f\x9a\x09\xf2\xd9\xf5\xfb\x1f\x5d\xbf" +
+	"\xf3\xe7\xc5\xfb\xae\x92\x73\x1f\x32\x9e\x92\x90\xd7\xe5\x02\xef\x4b\x91\x47\x98\xc4\x31\x37\x0d\xd6\x4d\x99\xbb" +
+	"\xd7\x28\x10\xb8\x65\x2d\x51\xfe\xc9\x0b\x64\xdd\x0d\xfb\xe8\x57\x95\xe9\x77\x09\xf8\x5d\x02\x7e\x55\x09\x98\xca" +
+	"\x88\x32\xa9\x12\x01\xff\x42\xfe\x37\x42\xf1\x57\x56\x81\x86\xf2\x96\x3a\x90\x12\x6c\x40\xd6\x7a\x1a\x4c\x93\xd5" +
+	"\x3a\x22\x29\xe3\xbb\x16\xcf\x8a\xe0\x89\x98\x99\xe1\xba\x67\xd9\x92\xde\xaa\xa6\xc0\x2e\x70\x7c\xd5\x14\x69\xcf" +
+	"\xe2\xc7\x04\x34\x9c\xa2\x82\x59\xab\x84\x10\x56\x86\x66\x9c\xe8\xc4\x68\x32\xf6\x32\xfc\xed\x7b\x1e\xdd\x2c\xfe" +
+	"\x91\xb5\xea\x2e\xa4\x94\xe7\x4d\x1b\x2f\x39\xb9\x58\xed\x93\x6d\x5f\x30\x9f\x35\x6c\x14\x3e\x69\x71\x99\x54\x9e" +
+	"\x62\x50\x8b\xc6\xa8\x1e\x31\x2d\x45\x53\x8b\x18\x3f\x5f\xd7\xb6\x33\xab\x45\x12\xc7\x6b\x12\xe5\x8d\x67\xc3\xf2" +
+	"\x5e\x6f\xf6\x2a\x70\x34\x33\x8c\x9b\x9b\xd9\x6b\x3d\x94\x79\xf8\x13\x18\x54\x22\xff\x3a\x98\xd8\xa3\x8a\x10\xcc" +
+	"\xa4\xfb\xc3\x24\xe6\x84\x89\xd1\x42\xb4\xa5\x29\xc5\xa6\x7e\xb5\xe6\xd8\xa9\x8a\xd3\x38\xda\xc9\x1e\x35\x5b\x3f" +
+	"\x9c\xca\xfe\x54\x2c\x61\x19\x67\x61\x26\x86\x09\x8a\x7d\xfc\x4e\xb6\xb2\x3d\x4b\x73\x2b\x7a\xd9\x1d\x76\xb2\x67" +
+	"\x27\x5f\x4f\x33\x51\x80\x54\x17\x7c\x0e\xdb\x27\x1a\x03\xc1\xd1\x64\x23\x8e\x2d\xf5\x94\xfe\x8b\x44\xf5\x70\x4a" +
+	"\x59\xc0\xb3\x2e\x5d\xa2\x91\x33\x43\x14\x77\x2e\xde\xca\x55\x5d\xc8\x12\xb4\x45\x38\x3c\x51\xb2\x41\xb3\x84\x45" +
+	"\x19\xcc\x13\x39\x15\xcd\x93\x25\x8b\x09\xa7\x02\xcc\x52\x22\xc8\x19\xd4\xe0\x0b\xc5\xc2\x00\x41\x28\xe8\x9c\x50" +
+	"\x4e\x55\xfc\x49\xe2\xcf\x1f\xa0\x90\x38\xa4\xa9\x18\xa8\xe4\xf3\x8c\x2c\x69\x3e\x26\xe0\xc7\x9d\x04\x38\xbe\x19" +
+	"\x8d\xb0\xdb\x4f\xab\x45\x42\x4d\xb2\xe6\xa0\xdc\x2e\xcd\xcf\x2c\xfc\xd5\x76\x47\x14\xfd\x39\xe5\x49\x4c\xb3\x1e" +
+	"\x90\x65\x22\xc6\x87\x6a\x58\x08\x39\xdb\xd0\x02\x8b\xca\xce\x6a\xcc\xa9\x8f\x5f\xca\xb5\xee\xcc\xb9\x1d\x06\x1f" +
+	"\x05\xab\xf9\x98\x52\x85\xdd\xcc\x1b\x79\xfd\x00\xd8\xbb\x13\x9c\x6d\xe4\x9a\x7b\x05\xb8\x27\x59\x86\xb7\x70\x2d" +
+	"\x15\xcf\x82\x7c\x4a\xea\x9d\x77\xbb\x62\x39\x2a\x53\x0f\xa4\x9a\x2b\x7f\xf2\xc9\x32\x59\x31\x35\x8f\x95\x70\x60" +
+	"\x05\x37\xb3\xe1\xf8\xba\x9a\xe1\x9c\xae\x9c\xb5\xf2\xa3\x6f\xde\x86\x4f\x1b\xbe\xc4\x2c\x56\x9f\xd1\x72\x60\x22" +
+	"\x84\xf3\x9e\x20\xcf\x97\x89\x3b\xc2\x91\xce\xeb\x14\x03\xde\x29\x90\xcd\x22\xff\xd0\x85\xc9\x67\xcf\x87\xed\x73" +
+	"\xf7\x4c\xf8\x6e\x78\xd5\xc9\xf8\x7c\x4e\x37\xf7\xab\x64\x65\x8a\xf4\xe0\xbc\x2b\x7e\x05\xac\x67\x19\x95\xa6\x81" +
+	"\x92\x8d\xdc\x46\xf9\x79\xaf\x99\xa6\x94\x6e\x09\xdf\xda\x8d\xb5\xef\x86\x1f\xb0\x1d\x5e\xb7\x22\x07\xdc\xd7\x6d" +
+	"\x8a\x1f\xb6\x2b\x9b\x41\x63\x6f\x79\x04\xdc\x8b\xfa\xd4\x29\xc8\x10\xc2\x55\x68\x28\xc1\xce\x5c\xf4\x4a\x86\xdc" +
+	"\x29\x5c\xbc\x3b\xef\x9e\x95\x81\x6a\x13\x9a\x63\x24\xdb\xd7\x62\xdb\x7f\x21\x81\xa9\xd7\xa6\x45\x43\x0b\xca\x89" +
+	"\x27\xad\x68\xf8\x41\x70\x78\x0b\x1e\x5e\x03\xa4\xee\x1e\xec\xb0\x6c\x8c\xf1\x6d\xb5\x6a\xdf\x36\xb8\xda\x87\x12" +
+	"\xac\x76\xd3\x77\x67\x1e\xdc\x7e\xf4\xc6\xa0\x95\x01\x18\xce\x60\x3c\x09\x54\x7d\x0b\xe4\x4b\x8b\xe5\x70\x0b\xde" +
+	"\x78\x20\x94\xaa\x55\x0d\xf3\x07\xea\xb6\x50\x15\x1e\x44\x68\xd8\x42\x68\x58\x11\x5a\x07\x68\x67\xf5\x40\x98\xa6" +
+	"\xe5\xba\xea\xa3\x19\x3d\x24\xb3\xc3\x03\x32\x3b\xd4\x12\xad\x06\xa9\x96\xde\xc7\x62\x7a\xa9\x0a\x84\x87\x55\x81" +
+	"\x56\x70\x65\x29\xa8\x0e\x92\xea\xa0\x92\xcf\x6e\x87\xe3\xc1\xe4\x56\x15\x84\xce\xd4\xf5\x83\x61\x30\x9c\x8c\xe1" +
+	"\xc3\x5d\x7e\x2c\xd4\x4f\x83\xf2\x86\x0e\x26\xfe\x00\x0d\xa3\x58\x0e\x44\x11\xa0\x82\xff\x27\xf4\xc8\x7b\xc2\x5c" +
+	"\x19\x3f\x16\x54\x75\xa3\xd8\x02\x8f\x1f\x8d\xaf\x5d\xa5\x8e\x74\xfb\x7c\xa4\xda\x12\xcf\xf3\xab\xf1\xe4\x2a\xc2" +
+	"\xe3\x49\xd2\x63\xd3\xce\x53\xf8\x0a\x9e\xf6\x68\xd5\xa9\x0a\x5f\xb1\x65\x4d\x75\x4b\x4f\x43\x16\x8b\x46\x5b\x53" +
+	"\x36\x34\xd5\x85\x10\x49\x53\xb2\xbb\x47\xe1\x0e\x66\x6a\x50\x94\x04\x5d\x61\xaf\x79\x52\xa9\x33\xa9\x59\xfa\xdb" +
+	"\x8e\x10\xbd\xf6\xeb\x6d\x53\xd1\xdd\x68\xbd\x47\xad\x41\x30\x0f\x6f\x4b\xb9\x6f\x2d\xb0\xb5\xf2\x58\x2f\x4c\x66" +
+	"\x25\x50\x3c\xcb\x33\xd0\x72\x1f\xd8\xad\x7c\x27\x9b\x4c\x17\x1d\x07\x57\xc3\x51\x80\xcf\x3a\x58\xd9\x7c\x2f\x77" +
+	"\x10\xfc\x05\x6f\xde\x48\x2d\xfa\xf4\xfa\x9b\xe6\x4a\xaf\xe2\xa9\x24\xba\x31\xea\x6b\x54\xe7\x94\xb6\x93\xfd\x47" +
+	"\xc9\x76\xf3\xc6\xa0\x2a\xed\xcd\xbc\x90\xef\xae\xfd\xc9\xcd\x54\x9a\xd1\x33\xc2\xe9\x3a\x46\xa2\x38\xa6\xdf\x54" +
+	"\xf6\xe9\xb6\xca\xdd\xd8\x2f\x48\xb4\x2d\xc9\xe2\x6e\xd9\x50\xed\x42\x4b\x3b\x19\xa5\x66\x39\x52\xb5\xe1\x68\xee" +
+	"\xdc\x82\xc6\x26\xf4\x22\x26\xcb\x55\x9b\x89\x4c\x89\x48\x7c\x4e\x0b\xd7\x88\xd7\x31\xa9\xbe\x74\x1c\x57\x5a\xb2" +
+	"\x8e\x8e\x83\x01\x4c\xfd\xe1\x27\xd7\xbf\x83\x7f\xbc\x3b\xfd\xbb\x37\xfd\xdb\xaf\x72\xc1\x65\x71\xa9\x50\xdc\x00" +
+	"\x19\x17\x0b\xf9\x64\x8b\x6f\x21\x7f\x7d\xf0\xfd\x81\x75\xc0\xad\xcc\xe0\x90\x6b\x16\x55\xd3\x47\xab\xf2\x5e\x50" +
+	"\x65\xa3\xb8\x02\xc4\x40\x63\x4b\x5a\x64\x7f\xad\xec\x14\xba\xad\xf5\x46\xce\x01\xb9\x6d\xa7\x9c\x7e\xcb\x47\x6a" +
+	"\xfe\xb5\x70\x6d\x7e\xe1\x5a\x5f\xd0\xe2\x0b\x6d\x97\x87\xfb\xa3\x5c\x74\xe9\xfc\x0f\xc9\xe3\xf5\xbb\x4b\x1e\x00" +
+	"\x00")
 
 func bindataDbTypessqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -422,10 +724,10 @@ func bindataDbTypessql() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "db/types.sql",
-		size: 1526,
+		size: 7755,
 		md5checksum: "",
 		mode: os.FileMode(420),
-		modTime: time.Unix(1573478514, 0),
+		modTime: time.Unix(1792370647, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -434,15 +736,17 @@ func bindataDbTypessql() (*asset, error) {
 }
 
 var _bindataTemplatesDatahtml = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8e\x4f\x6b\xc2\x40\x10\xc5\xef\xfb\x29\x06\xcf\xb2\x87\x5e\x83\x87" +
-	"\x88\xa6\x48\xa9\x2d\x2a\xf5\x50\x3c\x0c\xc9\x10\x17\x77\xc7\xb0\x59\xdb\xc0\x30\xdf\xbd\x24\xa1\x34\x78\x28\x94" +
-	"\xde\xde\x1f\xde\x8f\xf7\x81\x11\xb6\xeb\xc7\x7c\x97\x6f\x9f\xf6\xb0\x80\x77\x91\x88\x5c\x13\xd8\x1d\xf2\xc5\x71" +
-	"\xdd\xaa\x1a\x00\xe8\x8b\x21\x52\x9d\x8b\xd8\x57\x8a\x25\x71\x72\x9e\x46\xbf\x22\x6e\xe9\x3e\xdc\x70\x45\xdd\x28" +
-	"\xf3\x70\xe5\x7a\x94\xfb\x06\x79\x54\x6f\xe8\x6f\xa4\x7a\x9a\x8b\x10\x57\xaa\xe6\x94\x19\x23\xf2\xe9\xd2\x19\xec" +
-	"\xba\x4b\x14\x19\x7d\xe1\xc8\x57\xad\x3d\x5e\x63\xf5\xf0\x52\x96\xb7\xa8\xda\x7f\xfe\xf1\xb0\x00\x91\x44\xa1\xf1" +
-	"\x98\x08\x66\x01\x1b\x7b\x4e\xc1\xcf\xc0\xaa\x66\xdf\xe4\xdf\xa8\x87\x62\xb3\x2a\x26\xd4\xc1\xff\x87\xba\xbc\x3f" +
-	"\xbb\xfc\xe3\x5b\xd3\x8f\x82\xe3\xa3\xbb\xb8\x61\x60\x9f\x1d\x1f\x5c\xa0\x36\x61\x68\x54\xe7\x10\xb0\x9b\x94\xd8" +
-	"\x4d\xca\xec\x2b\x00\x00\xff\xff\x6a\x85\xa7\x59\xd3\x01\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x51\xcb\x6a\xc3\x30\x10\xbc\xfb\x2b\x44\xce\xc5\x87\x5c\x43\x0f\x4e" +
+	"\xe2\x14\xd3\xe6\x81\x13\x6a\x68\xc9\x61\xb1\xb6\x8e\x88\xb5\x32\xb2\x1c\x1b\x8c\xff\xbd\x8a\xe5\x90\x07\x14\x5a" +
+	"\xaa\xd3\xcc\x8e\x76\x76\x56\x3a\x81\x66\xab\xf0\x25\x88\x83\xd5\xeb\x96\x3d\xb3\xcf\xb6\xd5\x40\x19\x32\x3f\x06" +
+	"\x3a\x0a\xca\xca\xae\xf3\x98\x3d\x56\xe8\x4b\x5d\xf7\x64\xd1\x06\x75\x8a\x64\x44\x8e\x8e\xcf\x91\x4a\x7c\x2c\x46" +
+	"\xc4\xb1\x71\x30\x90\x8a\x32\x07\xb7\x05\x90\x43\xef\x90\x57\xc3\xd5\x8f\x6d\xaa\xf4\x80\xdf\x54\x76\x4b\x67\x8a" +
+	"\xbe\x04\x47\x4a\x2d\xdf\xdb\x02\x12\xb7\x91\xf6\x13\xcf\x6b\xdb\x5a\x98\x03\x73\x73\xc6\x76\xba\x50\x7c\x89\x50" +
+	"\x56\x1a\xa5\xcd\x61\x93\x9f\x86\xed\x36\x61\x1c\xad\xe7\xe7\xfd\xac\x61\xd7\x4d\x2e\x2e\x17\x87\x18\xea\x99\xaa" +
+	"\xee\x5a\xe2\x20\xf9\xf1\x7a\xd8\x18\xd4\x04\xf9\x42\x60\xce\x4b\x3f\x51\x9a\x8f\xd7\x69\x5a\x69\xd7\x7e\xe5\xbd" +
+	"\x83\x41\x59\xe4\x60\x90\x8d\x24\x14\xfe\xc1\xc8\x7c\xc4\x7e\xeb\xba\x5b\x44\xf3\xc5\x8d\x6b\xcf\xff\xe3\x3a\x7d" +
+	"\x0c\x3b\xfd\x63\x5a\xef\xdc\x24\x05\x25\xe2\x28\xdc\x03\x2d\x05\xed\x84\xc4\xd2\x80\x2c\xec\x97\x31\x09\xcd\x8d" +
+	"\x08\xcd\x9d\x58\x0b\xe2\xaa\xbe\xea\x49\xcf\xad\xff\x37\xbe\xb6\xfd\x44\x89\x02\x00\x00")
 
 func bindataTemplatesDatahtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -461,10 +765,10 @@ func bindataTemplatesDatahtml() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "templates/data.html",
-		size: 467,
+		size: 649,
 		md5checksum: "",
 		mode: os.FileMode(436),
-		modTime: time.Unix(1569247484, 0),
+		modTime: time.Unix(1792370096, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -543,26 +847,27 @@ func bindataTemplatesMaphtml() (*asset, error) {
 }
 
 var _bindataTemplatesPagehtml = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\xf8\xf1\x1c\x8b\x5f\x6e\x45\x20" +
-	"\x19\x28\xd2\xf6\x14\xb4\x41\x91\x20\xe8\x91\x21\xd7\xd2\x3a\xfc\x2b\xb9\xb1\x6a\x08\x7c\xf7\x82\x92\x7f\x54\xa7" +
-	"\x68\x4e\x06\x77\x3d\x33\xbb\x3b\x03\x35\xff\x7d\xfa\x76\xfb\xf0\xe3\xfe\x33\xeb\xc9\x9a\x75\xd5\x94\x1f\x66\xa4" +
-	"\xeb\x5a\x3e\x8e\xf5\x9d\x74\x5d\xce\xbc\xd4\x41\x6a\x86\x7a\xaa\xde\xcb\x0e\xea\x87\x7d\x80\x9c\x39\xd3\x92\xe4" +
-	"\x8a\x7c\x40\xb5\xe8\x95\xe7\x8c\xb3\x40\x92\xa9\x5e\xc6\x04\xd4\xf2\xc7\x87\x2f\xab\x0f\x5c\x1c\xeb\x4e\x5a\x68" +
-	"\xf9\x0e\x61\x08\x3e\x12\x67\xca\x3b\x02\x47\x2d\x1f\x50\x53\xdf\x6a\xd8\xa1\x82\xd5\xf4\xb8\x62\xe8\x90\x50\x9a" +
-	"\x55\x52\xd2\x40\x7b\x5d\xff\x7f\xc5\x2c\x3a\xb4\xaf\xf6\x5c\x3a\x53\x87\xe8\x03\x44\xda\xb7\xdc\x77\x37\x68\x65" +
-	"\x07\x0b\xfa\x71\xac\x9f\x9e\x9e\x1e\xbf\xdf\xe5\x2c\xa6\x5e\x12\xc6\x77\xbe\x0e\xae\x2b\x0c\x55\x43\x48\x06\xd6" +
-	"\xe3\x88\x1b\x06\x3f\xd9\x79\x61\xc6\xa7\x4d\x79\xce\xb7\x92\xa0\xf3\x71\x7f\xc3\xc6\x11\x9c\xce\xf9\xb4\x7b\x81" +
-	"\xe6\xcc\x56\xec\x2b\x74\x32\x80\x46\xd9\x88\x99\xaf\xaa\x1a\x83\xee\x85\x45\x30\x2d\x4f\xb4\x37\x90\x7a\x00\x62" +
-	"\x21\xc2\x06\x48\xf5\x9c\xd1\x3e\x40\xcb\x09\x7e\x91\x50\x29\x71\xd6\x47\xd8\xfc\x39\xae\x4a\x49\x28\x1f\xa1\x2e" +
-	"\xfd\xc2\x98\x54\xc4\x40\x4b\xe4\x56\xee\xe4\x5c\xe5\x2c\x45\xd5\xf2\x9e\x28\xa4\x1b\x21\x86\x61\xa8\xbb\x44\x92" +
-	"\x50\xd5\xca\x5b\x51\x6c\xa1\xb2\xba\xd4\x10\xeb\x6d\xe2\xeb\x46\xcc\xc0\xf5\x3b\xbc\xeb\x6a\x1c\x09\x6c\x30\x92" +
-	"\x80\xf1\x12\x81\xba\x04\x87\xb3\x3a\xe7\xea\x4c\x52\x55\xcd\xb3\xd7\xfb\x32\xa6\xc6\xdd\x14\x1f\x0b\xee\xb5\xe8" +
-	"\x68\xdc\x2d\xcb\x07\x6b\xa6\x8d\xfa\x6b\xf6\xb7\xcb\xcb\x48\xa8\x0c\xf0\x9c\xe7\xcc\xcd\xe1\x39\x9e\xfd\xd1\x69" +
-	"\x88\xa9\x5c\x46\x1f\x1c\xe0\x07\x63\xd6\x17\xce\x34\xa2\xbf\x5e\x4a\xa3\xa3\xe8\xcf\x23\xfd\x5b\xfa\x0c\x1b\xf0" +
-	"\x05\x27\x77\x57\x9b\xe8\x2d\x3f\xa9\x7c\x7c\x4e\x14\xa5\xa2\x22\x74\xb1\xa4\x83\x4e\x0e\x32\xea\x74\xa1\x56\xdf" +
-	"\xa1\x7b\x49\x4b\xf2\xe4\x55\x89\xfa\xf6\xd5\x86\x74\x71\xec\x20\x3b\x30\x98\xe8\x78\xf0\x13\x76\x62\x3c\x85\x11" +
-	"\x4c\x82\x25\xe3\x1b\xed\xc3\x3f\xab\xea\xcd\x98\x11\x92\x37\x3b\x88\xfc\xbd\x14\xcc\xe9\x5a\xa6\x73\x9b\xc4\x11" +
-	"\x7d\x99\xa7\xa3\x8a\x98\x12\xd1\x88\xf9\x7b\xf3\x3b\x00\x00\xff\xff\x16\x51\xd3\x45\x80\x04\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x54\x4d\x8f\xd3\x30\x10\xbd\xf7\x57\x18\x9f\xb7\x31\xbd\xa1\xaa\xa9" +
+	"\x84\x16\x38\x55\xb0\x42\xad\x2a\x8e\x5e\x7b\x9a\xb8\xeb\xd8\xc1\x9e\x36\x54\x51\xfe\x3b\xe3\xf4\x23\xd9\x2e\x02" +
+	"\x4e\x89\x67\x3c\xef\xcd\xcc\x7b\xc9\xe2\xdd\xa7\x6f\x8f\xeb\x1f\x4f\x9f\x59\x89\x95\x5d\x4e\x16\xe9\xc1\xac\x74" +
+	"\x45\xce\xdb\x36\x5b\xd1\x4b\xd7\xf1\x14\x07\xa9\x99\xd1\x7d\xf4\x49\x16\x90\xad\x4f\x35\x50\x8a\x69\x89\x72\x8a" +
+	"\xbe\x36\x6a\x94\x4b\xc7\x73\x5d\x05\x28\x99\x2a\x65\x88\x80\x39\xdf\xac\xbf\x4c\x3f\x70\x71\x8d\x3b\x59\x41\xce" +
+	"\x8f\x06\x9a\xda\x07\xe4\x4c\x79\x87\xe0\xe8\x5e\x63\x34\x96\xb9\x86\xa3\x51\x30\xed\x0f\x0f\xcc\x38\x83\x46\xda" +
+	"\x69\x54\xd2\x42\x3e\xcb\xde\x3f\xb0\x8a\x62\xd5\xa1\x1a\x42\x03\x74\x1d\x7c\x0d\x01\x4f\x39\xf7\xc5\xdc\x54\xd4" +
+	"\xd5\x08\x9e\xfa\xdc\x6e\xb7\x9b\xef\xab\xae\x13\x7d\x2e\x0a\xeb\x0b\x9f\xd5\xae\x48\x08\x93\x05\x1a\xb4\xb0\x6c" +
+	"\x5b\xb3\x63\xf0\x93\x0d\x03\x33\xde\x4f\xca\xbb\xee\x51\x22\x14\x3e\x9c\xe6\xac\x6d\xc1\xe9\xae\xbb\xcd\x9e\x4a" +
+	"\xbb\x8e\x4d\xd9\x57\x28\x64\x0d\xda\xc8\xb6\x6d\x0c\x96\x2c\xdb\x1a\xa7\x7d\x43\x39\xba\x9b\x0a\xfa\xba\x85\x38" +
+	"\x93\x11\xab\x35\xee\x85\x05\xb0\x39\x8f\x78\xb2\x10\x4b\x00\xa4\x41\x60\x07\xa8\x4a\xce\x90\x1a\xc8\x39\xc2\x2f" +
+	"\x14\x2a\x46\xce\x4a\xca\xbc\x9e\x85\xc2\x42\xf9\x00\x59\xca\x27\xc4\xa8\x82\xa9\x71\x5c\xb9\x97\x47\x79\x8e\x72" +
+	"\x16\x03\x69\x56\x22\xd6\x71\x2e\x44\xd3\x34\x59\x11\x51\xa2\x51\x99\xf2\x95\x48\x9a\x61\xda\x8b\xd4\x10\xb2\x3d" +
+	"\xe1\x2d\xc4\xb9\x70\xf9\x0f\xdc\xe5\xa4\x6d\x11\xaa\xda\xd2\x8a\x18\x4f\xfe\xc8\x92\xab\x38\xa3\xa1\x27\x03\x08" +
+	"\xb5\xf7\xec\xf5\x29\xb5\xa9\xcd\xb1\xf7\x56\x05\xee\x90\x78\xe8\x3c\x0e\x5f\x74\xeb\x27\x2a\x67\xec\x4f\xb2\x50" +
+	"\xaf\x46\x59\x20\x61\xce\x86\x3c\x3b\xeb\xaa\xc9\xc6\xd1\x0c\x31\x6d\x46\x5f\xe4\xe1\x97\xed\x2f\xef\x64\x5b\x88" +
+	"\x72\x36\xa6\x36\x0e\x83\x1f\x5a\xfa\x3b\xf5\x50\xd6\x98\x17\xd3\x4b\x3f\xdd\x05\x5f\xf1\x1b\xcb\xc7\xe7\x88\x41" +
+	"\x2a\x4c\x44\x77\x43\x3a\x72\x4b\x23\x83\x8e\x77\x6c\xd9\x8a\x5c\x11\xc7\xe0\xd1\xab\xf4\x1d\xec\x0f\x55\x1d\xef" +
+	"\x96\x5d\x13\x89\x35\x11\xaf\x0b\xbf\xd5\xf6\x88\x37\xa7\x82\x8d\x30\x46\x7c\xc3\x7d\xb9\x39\x99\xbc\x69\x33\x40" +
+	"\xf4\xf6\x08\x81\x2f\xff\xcb\x5d\x63\x77\xee\xa3\xb8\x56\xdf\xfb\xe9\xca\x22\x7a\x47\x90\x08\xfd\xcf\xe8\x37\xfd" +
+	"\xbb\x29\x6f\x9d\x04\x00\x00")
 
 func bindataTemplatesPagehtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -581,10 +886,10 @@ func bindataTemplatesPagehtml() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "templates/page.html",
-		size: 1152,
+		size: 1181,
 		md5checksum: "",
 		mode: os.FileMode(436),
-		modTime: time.Unix(1573478443, 0),
+		modTime: time.Unix(1792367519, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -629,22 +934,23 @@ func bindataTemplatesPagelisthtml() (*asset, error) {
 }
 
 var _bindataTemplatesToptenhtml = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\x4f\x6f\xdb\x3c\x0c\xc6\xef\xfe\x14\x7a\x75\xae\xad\x37\xb7\x61" +
-	"\x90\x73\xd9\x1f\x60\x40\xb1\x0d\x5d\x8a\x60\x47\x4e\x62\x6c\xa6\xb2\x24\x48\xac\xdb\xc0\xf0\x77\x1f\x64\xb7\x8d" +
-	"\xbb\x93\xe8\x87\x8f\x7e\x14\x69\xea\xff\x3e\xff\xf8\x74\xf8\xfd\xf3\x8b\xe8\x79\x70\xfb\x4a\x97\x43\x38\xf0\x5d" +
-	"\x2b\xa7\xa9\xb9\x05\xdf\xcd\xb3\x2c\x3a\x82\x15\x64\x5b\xc9\x21\x32\x7a\x29\x2c\x30\xd4\x7c\x89\xb8\x18\xbf\x79" +
-	"\x8b\xcf\xf3\xfc\x22\x47\x4c\x14\xec\x92\xf8\x15\xc1\xbf\xe9\x1c\x22\x99\x45\x3e\x94\x68\x25\x0f\xc8\x20\x4c\x0f" +
-	"\x29\x23\xb7\xf2\xfe\xf0\xb5\xfe\x20\xd5\xab\xee\x61\xc0\x56\x8e\x84\x4f\x31\x24\x96\xc2\x04\xcf\xe8\xb9\x95\x4f" +
-	"\x64\xb9\x6f\x2d\x8e\x64\xb0\x5e\x3e\x6e\x04\x79\x62\x02\x57\x67\x03\x0e\xdb\x5d\xf3\xff\x8d\x18\xc8\xd3\xf0\x38" +
-	"\x5c\xa5\x2b\x3a\xa6\x10\x31\xf1\xa5\x95\xa1\xfb\x48\x03\x74\xb8\xc1\x4f\x53\x73\x3c\x1e\xef\xef\x6e\xe7\x59\x2d" +
-	"\xb9\xac\x5c\xe8\x42\x13\x7d\x57\x08\x95\x66\x62\x87\xfb\xd2\x49\x09\xe6\x59\xd4\xe2\x3b\x76\x10\xd1\x12\x68\xb5" +
-	"\x66\xab\x4a\x3b\xf2\x0f\x22\xa1\x6b\x65\xe6\x8b\xc3\xdc\x23\xb2\x88\x09\x4f\xc8\xa6\x97\x62\x9d\x1f\xe3\x33\x2b" +
-	"\x93\xb3\x14\x7d\xc2\xd3\xfb\xe2\x26\x67\x65\x42\xc2\xa6\xe4\x0b\xf1\x4f\xb0\x97\x72\x5a\x1a\x97\xff\x31\xa0\x7f" +
-	"\x94\x7b\xad\x2c\x8d\x5b\xf9\xa5\x93\xe5\x4a\xbf\xdb\x3c\x54\xab\x7e\xb7\x35\x92\xe7\x14\xde\x00\xd3\xc4\x38\x44" +
-	"\x07\x8c\x42\x46\xe8\xd0\x51\xe6\xa6\xec\x84\x14\xcd\x1d\xf8\x07\x2a\xfb\x50\x55\xfa\x14\x02\x63\x5a\x00\x6b\x58" +
-	"\x08\x6b\x54\xe0\xff\xbe\x26\x61\x0e\x6e\x2c\xae\x4a\x67\x93\x28\xf2\xb6\xf5\x33\x8c\xb0\xaa\x52\xe4\x64\xde\x0f" +
-	"\xe0\x9c\xd5\xeb\xed\xe6\x9c\x4b\x99\xd5\xba\xbf\x56\x51\xcb\x4c\xb4\x5a\x57\xf8\x6f\x00\x00\x00\xff\xff\x4d\x60" +
-	"\x6f\x0a\xd3\x02\x00\x00")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x53\x4d\x6f\xdc\x20\x10\xbd\xef\xaf\xa0\x9c\x63\x93\xdc\xaa\xca\xde" +
+	"\x4b\xda\x48\x95\xa2\xb6\x4a\x37\x5a\xf5\x48\x61\x62\xb3\xc1\x80\x60\xe2\xcd\xca\xf2\x7f\xef\x80\xb3\x59\xa7\x27" +
+	"\x66\xde\xbc\x79\xcc\xe3\xa3\xf9\xf4\xf5\xe7\xed\xee\xcf\xaf\x6f\xac\xc7\xc1\x6e\x37\x4d\x5e\x98\x95\xae\x6b\xf9" +
+	"\x34\xd5\xf7\x14\xcc\x33\xcf\x38\x48\xcd\x8c\x6e\x39\xfa\x80\xe0\x38\xd3\x12\x65\x85\xa7\x00\x85\xf8\xdd\x69\x78" +
+	"\x25\xe6\x02\x07\x88\xc6\xeb\x52\xf8\x1d\xa4\x7b\xc7\xa9\xd7\xa8\x02\xef\x72\x44\xf8\x34\x1d\x0d\xf6\xac\xbe\xf5" +
+	"\xbd\x8f\x38\xcf\x0b\x4f\x95\xac\x10\x0b\x07\x9c\x9e\x67\x1a\x62\x00\x94\x4c\xf5\x32\x26\xa0\xea\xe3\xee\xae\xfa" +
+	"\xcc\xc5\x19\x77\x72\xa0\x59\x46\x03\xc7\x40\xcd\x9c\x29\xef\x68\x50\xe2\x1d\x8d\xc6\xbe\xd5\x30\x1a\x05\x55\x49" +
+	"\xae\x98\x71\x06\x8d\xb4\x55\x52\xd2\x42\x7b\x53\x5f\x5f\xb1\x81\xb0\xe1\x65\xb8\x40\x17\xe9\x10\x3d\x59\xc2\x53" +
+	"\xcb\x7d\xf7\xc5\x0c\xb2\x83\x95\x3c\x0d\xb9\xdf\xef\x1f\x1f\xee\xe7\x59\x94\x5a\x12\xd6\x77\xbe\x0e\xae\xcb\x0a" +
+	"\x9b\x06\x0d\x5a\xd8\x66\xd3\x39\x20\x8f\x15\xfb\x01\x9d\x0c\xa0\x8d\x3c\xfb\xdf\x1b\xa7\xfd\x91\x6a\xc5\xf2\x9b" +
+	"\xe3\x46\x2c\xad\xa4\x61\x8d\x7b\x66\x11\x6c\xcb\x13\x9e\x2c\xa4\x1e\x00\x69\x2c\x78\x02\x54\x3d\x67\xcb\x3d\x20" +
+	"\xbc\xa2\x50\x29\x71\xd6\x53\xe5\xe3\x64\x04\x0b\xe5\x23\xd4\xb9\x9e\x15\xff\x7a\x7d\xca\xab\x36\x63\xb9\xd7\x01" +
+	"\xdc\x0b\xdf\x36\x82\xf2\x35\xfc\x66\xb3\xb4\xf4\x37\x2b\x17\x8d\xa0\x74\x45\x34\x0e\xa3\x7f\x17\x98\x26\x84\x21" +
+	"\x58\x89\xc0\x78\xa0\x33\xb1\x26\x61\x9d\xdf\x16\x67\xf5\x83\x74\xcf\x26\xbf\x2b\xea\x7e\xf2\x1e\x21\x16\x81\x25" +
+	"\xcc\x0a\x4b\x94\xc5\xff\x9f\x26\x42\xf2\x76\xcc\xac\x4d\x93\x54\x34\x01\xd7\xd6\x0f\x72\x94\x0b\xca\x59\x8a\xea" +
+	"\xe3\x01\x1c\x92\x38\x77\xd7\x87\x94\xb7\x59\xa8\xdb\xcb\x2e\xa2\x9c\x09\x19\x2b\x5f\xe1\x1f\xa8\xe1\x9b\xb8\x1b" +
+	"\x03\x00\x00")
 
 func bindataTemplatesToptenhtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -663,10 +969,10 @@ func bindataTemplatesToptenhtml() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "templates/topten.html",
-		size: 723,
+		size: 795,
 		md5checksum: "",
 		mode: os.FileMode(436),
-		modTime: time.Unix(1573478447, 0),
+		modTime: time.Unix(1792368227, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -738,18 +1044,21 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 //
 var _bindata = map[string]func() (*asset, error){
-	"db/base.sql":                 bindataDbBasesql,
-	"db/indices.sql":              bindataDbIndicessql,
-	"db/query-pages.sql":          bindataDbQuerypagessql,
-	"db/query-toptenbyyear.sql":   bindataDbQuerytoptenbyyearsql,
-	"db/test.sql":                 bindataDbTestsql,
-	"db/types.sql":                bindataDbTypessql,
-	"templates/data.html":         bindataTemplatesDatahtml,
-	"templates/homepagedata.html": bindataTemplatesHomepagedatahtml,
-	"templates/map.html":          bindataTemplatesMaphtml,
-	"templates/page.html":         bindataTemplatesPagehtml,
-	"templates/pagelist.html":     bindataTemplatesPagelisthtml,
-	"templates/topten.html":       bindataTemplatesToptenhtml,
+	"db/base.sql":                     bindataDbBasesql,
+	"db/indices.sql":                  bindataDbIndicessql,
+	"db/quality.sql":                  bindataDbQualitysql,
+	"db/query-cohorttoptenbyyear.sql": bindataDbQuerycohorttoptenbyyearsql,
+	"db/query-pages.sql":              bindataDbQuerypagessql,
+	"db/query-toptenbyyear.sql":       bindataDbQuerytoptenbyyearsql,
+	"db/stats.sql":                    bindataDbStatssql,
+	"db/test.sql":                     bindataDbTestsql,
+	"db/types.sql":                    bindataDbTypessql,
+	"templates/data.html":             bindataTemplatesDatahtml,
+	"templates/homepagedata.html":     bindataTemplatesHomepagedatahtml,
+	"templates/map.html":              bindataTemplatesMaphtml,
+	"templates/page.html":             bindataTemplatesPagehtml,
+	"templates/pagelist.html":         bindataTemplatesPagelisthtml,
+	"templates/topten.html":           bindataTemplatesToptenhtml,
 }
 
 //
@@ -807,8 +1116,11 @@ var _bintree = &bintree{Func: nil, Children: map[string]*bintree{
 	"db": {Func: nil, Children: map[string]*bintree{
 		"base.sql": {Func: bindataDbBasesql, Children: map[string]*bintree{}},
 		"indices.sql": {Func: bindataDbIndicessql, Children: map[string]*bintree{}},
+		"quality.sql": {Func: bindataDbQualitysql, Children: map[string]*bintree{}},
+		"query-cohorttoptenbyyear.sql": {Func: bindataDbQuerycohorttoptenbyyearsql, Children: map[string]*bintree{}},
 		"query-pages.sql": {Func: bindataDbQuerypagessql, Children: map[string]*bintree{}},
 		"query-toptenbyyear.sql": {Func: bindataDbQuerytoptenbyyearsql, Children: map[string]*bintree{}},
+		"stats.sql": {Func: bindataDbStatssql, Children: map[string]*bintree{}},
 		"test.sql": {Func: bindataDbTestsql, Children: map[string]*bintree{}},
 		"types.sql": {Func: bindataDbTypessql, Children: map[string]*bintree{}},
 	}},
@@ -0,0 +1,156 @@
+DROP SCHEMA IF EXISTS w2o CASCADE;
+CREATE SCHEMA w2o;
+
+/*Myindex represents page type, it can be global, topic or article*/
+CREATE TYPE w2o.mypagetype AS ENUM ('global', 'topic', 'article');
+
+CREATE COLLATION w2o.mycollate (LOCALE = 'en_US.UTF-8');
+
+/*Pages represents wikipedia articles and overpedia topics*/
+CREATE TABLE w2o.pages (
+    page_id            INTEGER NOT NULL,
+    page_title         VARCHAR(512) COLLATE w2o.mycollate,
+    page_abstract      TEXT COLLATE w2o.mycollate,
+    parent_id          INTEGER NOT NULL,
+    page_socialjumps   INTEGER[] NOT NULL DEFAULT '{}',
+    page_type          w2o.mypagetype NOT NULL DEFAULT 'article'::w2o.mypagetype,
+    page_creationyear  INTEGER,
+    page_cohort        INTEGER
+);
+
+/*Revisions represents wikipedia article edits, rev_period is the label of their sub-year period (e.g. 2010-Q1 or 2010-01), NULL if granularity is year*/
+CREATE TABLE w2o.revisions (
+    page_id            INTEGER NOT NULL,
+    rev_serialid       INTEGER NOT NULL,
+    user_id            INTEGER,
+    user_isbot         BOOLEAN NOT NULL,
+    rev_charweight     FLOAT NOT NULL,
+    rev_chardiff       FLOAT NOT NULL,
+    rev_isrevert       INTEGER NOT NULL,
+    rev_isreverted     BOOLEAN NOT NULL,
+    rev_timestamp      TIMESTAMP NOT NULL,
+    rev_year           INTEGER,
+    rev_period         TEXT
+);
+
+/*Analysiswindow restricts the analysis to the revisions in [windowfrom, windowto), an empty bound is unbounded*/
+CREATE TABLE w2o.analysiswindow AS
+SELECT CAST(NULLIF(:'windowfrom', '') AS TIMESTAMP) AS windowfrom, CAST(NULLIF(:'windowto', '') AS TIMESTAMP) AS windowto;
+
+/*Editwars represents mutual reverts between user pairs, that is reverts of a user that reverted the reverting user shortly before*/
+CREATE TABLE w2o.editwars (
+    page_id            INTEGER NOT NULL,
+    user_id            INTEGER NOT NULL,
+    reverted_user_id   INTEGER NOT NULL,
+    rev_timestamp      TIMESTAMP NOT NULL,
+    rev_year           INTEGER,
+    rev_period         TEXT
+);
+
+/*Newcomers represents the first edits of registered users to articles, and whether they were reverted shortly after*/
+CREATE TABLE w2o.newcomers (
+    page_id            INTEGER NOT NULL,
+    user_id            INTEGER NOT NULL,
+    rev_isreverted     BOOLEAN NOT NULL,
+    rev_timestamp      TIMESTAMP NOT NULL,
+    rev_year           INTEGER,
+    rev_period         TEXT
+);
+
+/*Socialjumps is a temporary table used for loading socialjumps, later data is merged into pages table*/
+CREATE TABLE w2o.socialjumps (
+    page_id            INTEGER NOT NULL,
+    page_socialjumps   INTEGER[10]
+);
+
+
+/*Load data and define table indexes*/
+
+/*Dummy page used for global statistics*/
+INSERT INTO w2o.pages(page_id, parent_id, page_type) VALUES (0, 0, 'global'::w2o.mypagetype);
+
+COPY w2o.pages(page_id,page_title,page_abstract,parent_id) FROM :'pagesfilepath' WITH CSV HEADER;
+COPY w2o.revisions(page_id,rev_serialid,user_id,user_isbot,rev_charweight,rev_chardiff, rev_isrevert, rev_isreverted, rev_timestamp) FROM :'revisionsfilepath' WITH CSV HEADER;
+COPY w2o.editwars(page_id,user_id,reverted_user_id,rev_timestamp) FROM :'editwarsfilepath' WITH CSV HEADER;
+COPY w2o.newcomers(page_id,user_id,rev_isreverted,rev_timestamp) FROM :'newcomersfilepath' WITH CSV HEADER;
+
+ALTER TABLE w2o.pages
+    ADD PRIMARY KEY (page_id),
+    ADD FOREIGN KEY (parent_id) REFERENCES w2o.pages (page_id);
+UPDATE w2o.pages SET page_type = 'topic'::w2o.mypagetype WHERE parent_id=0 AND page_id!=0;
+CLUSTER w2o.pages USING pages_pkey;
+ANALYZE w2o.pages;
+CREATE INDEX ON w2o.pages (page_type, page_title);
+
+/*Page creation is taken from the whole history, pages created after the analysis window are dropped*/
+CREATE TABLE w2o.pagecreations AS
+SELECT page_id, MIN(rev_timestamp) AS page_creation
+FROM w2o.revisions
+GROUP BY page_id;
+DELETE FROM w2o.revisions USING w2o.analysiswindow WHERE rev_timestamp < windowfrom OR rev_timestamp >= windowto;
+DELETE FROM w2o.editwars USING w2o.analysiswindow WHERE rev_timestamp < windowfrom OR rev_timestamp >= windowto;
+UPDATE w2o.editwars SET rev_year = CAST (EXTRACT(YEAR FROM date_trunc('year', rev_timestamp)) AS INTEGER),
+    rev_period = CASE :'granularity' WHEN 'quarter' THEN to_char(rev_timestamp, 'YYYY-"Q"Q') WHEN 'month' THEN to_char(rev_timestamp, 'YYYY-MM') END;
+ANALYZE w2o.editwars;
+DELETE FROM w2o.newcomers USING w2o.analysiswindow WHERE rev_timestamp < windowfrom OR rev_timestamp >= windowto;
+UPDATE w2o.newcomers SET rev_year = CAST (EXTRACT(YEAR FROM date_trunc('year', rev_timestamp)) AS INTEGER),
+    rev_period = CASE :'granularity' WHEN 'quarter' THEN to_char(rev_timestamp, 'YYYY-"Q"Q') WHEN 'month' THEN to_char(rev_timestamp, 'YYYY-MM') END;
+DELETE FROM w2o.pages USING w2o.pagecreations, w2o.analysiswindow WHERE pages.page_id = pagecreations.page_id AND page_creation >= windowto;
+/*Articles without revisions have no creation year: they are counted for the articles-without-revisions quality check and dropped;
+articles created before the analysis window may have no revisions in it, so they are not checked*/
+CREATE TABLE w2o.revisionlessarticles AS
+SELECT COUNT(*) FILTER (WHERE page_creation IS NULL) AS faulty, COUNT(*) AS total
+FROM w2o.pages LEFT JOIN w2o.pagecreations USING (page_id), w2o.analysiswindow
+WHERE page_type = 'article'::w2o.mypagetype AND (page_creation IS NULL OR windowfrom IS NULL OR page_creation >= windowfrom);
+DELETE FROM w2o.pages WHERE page_type = 'article'::w2o.mypagetype AND page_id NOT IN (SELECT page_id FROM w2o.pagecreations);
+DELETE FROM w2o.editwars WHERE page_id NOT IN (SELECT page_id FROM w2o.pages);
+DELETE FROM w2o.newcomers WHERE page_id NOT IN (SELECT page_id FROM w2o.pages);
+ANALYZE w2o.newcomers;
+
+UPDATE w2o.revisions SET rev_year = CAST (EXTRACT(YEAR FROM date_trunc('year', rev_timestamp)) AS INTEGER),
+    rev_period = CASE :'granularity' WHEN 'quarter' THEN to_char(rev_timestamp, 'YYYY-"Q"Q') WHEN 'month' THEN to_char(rev_timestamp, 'YYYY-MM') END;
+ALTER TABLE w2o.revisions
+    ADD PRIMARY KEY (page_id,rev_serialid),
+    ADD FOREIGN KEY (page_id) REFERENCES w2o.pages (page_id),
+    ALTER COLUMN rev_year SET NOT NULL;
+CLUSTER w2o.revisions USING revisions_pkey;
+ANALYZE w2o.revisions;
+CREATE INDEX ON w2o.revisions (user_id);
+
+CREATE TABLE w2o.timebounds AS
+SELECT MIN(rev_year) AS minyear, MAX(rev_year) AS maxyear,
+MIN(rev_timestamp) AS mintimestamp, MAX(rev_timestamp) AS maxtimestamp,
+(SELECT windowfrom FROM w2o.analysiswindow) AS windowfrom, (SELECT windowto FROM w2o.analysiswindow) AS windowto
+FROM w2o.revisions;
+DROP TABLE w2o.analysiswindow;
+
+COPY w2o.socialjumps FROM :'socialjumpsfilepath' WITH CSV HEADER;
+/*Page cohort is the real creation year of articles, it's NULL for articles created before the analysis window, whose creation year is clamped to its start*/
+UPDATE w2o.pages SET (page_socialjumps,page_creationyear,page_cohort) = (_.page_socialjumps, _.page_creationyear, _.page_cohort)
+  FROM (
+    WITH pagecreation AS (
+    SELECT page_id, minyear AS page_creationyear, NULL::INTEGER AS page_cohort
+    FROM w2o.timebounds, w2o.pages
+    WHERE page_type != 'article'::w2o.mypagetype
+    UNION ALL
+    SELECT page_id, GREATEST(creationyear, minyear) AS page_creationyear, CASE WHEN creationyear >= minyear THEN creationyear END AS page_cohort
+    FROM (SELECT page_id, CAST (EXTRACT(YEAR FROM page_creation) AS INTEGER) AS creationyear FROM w2o.pagecreations) _, w2o.timebounds)
+    SELECT page_id, COALESCE(sj.page_socialjumps,'{}') AS page_socialjumps, page_creationyear, page_cohort
+    FROM pagecreation LEFT JOIN w2o.socialjumps sj USING (page_id)
+  ) _ WHERE _.page_id = pages.page_id;
+DROP TABLE w2o.socialjumps;
+ALTER TABLE w2o.pages
+    ALTER COLUMN page_creationyear SET NOT NULL;
+
+
+/*Pagetree contains the complete graph of parent relations*/
+CREATE MATERIALIZED VIEW w2o.pagetree AS
+SELECT page_id, parent_id
+FROM w2o.pages
+UNION
+SELECT p2.page_id, p1.parent_id
+FROM w2o.pages p1 JOIN w2o.pages p2 ON p1.page_id=p2.parent_id;
+
+CREATE INDEX pagetree_cluster_index ON w2o.pagetree (page_id, parent_id);
+CLUSTER w2o.pagetree USING pagetree_cluster_index;
+ANALYZE w2o.pagetree;
\ No newline at end of file
@@ -0,0 +1,250 @@
+/*Myindex represents index types of statistics*/
+CREATE TYPE w2o.myindex AS ENUM ('conflict', 'polemic', 'editwar', 'newcomerrevert', 'anonymous', 'bot', 'anonymousrevert', 'botconflict');
+
+/*Indices and their counts are keyed by year and period: yearly ones have an empty period, year 0 stands for the whole analysis and,
+unless granularity is year, sub-year ones have the period label and its year. Counts of revisions group by the three keys at once,
+skipping the sub-year ones of revisions without period*/
+
+/*Socialcountsbyyear is a temporary table with popularity (NULL type), conflict and botconflict of every page by year and period, i.e. its distinct editors and reverters*/
+/*Bots are counted in popularity and conflict only with bot policy include, with bot policy separate reverting bots are counted in botconflict*/
+CREATE TABLE w2o.socialcountsbyyear AS
+WITH articleusersocialindices AS (
+    SELECT NULL::w2o.myindex /*ex S. Popularity*/ AS type, page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, user_id
+    FROM w2o.revisions
+    WHERE user_id IS NOT NULL AND (NOT user_isbot OR :'botpolicy' = 'include')
+    GROUP BY GROUPING SETS ((page_id, rev_year, user_id), (page_id, user_id), (page_id, rev_year, rev_period, user_id))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+    UNION ALL
+    SELECT 'conflict'::w2o.myindex AS type, page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, user_id
+    FROM w2o.revisions
+    WHERE user_id IS NOT NULL AND (NOT user_isbot OR :'botpolicy' = 'include') AND rev_isrevert > 0
+    GROUP BY GROUPING SETS ((page_id, rev_year, user_id), (page_id, user_id), (page_id, rev_year, rev_period, user_id))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+    UNION ALL
+    SELECT 'botconflict'::w2o.myindex AS type, page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, user_id
+    FROM w2o.revisions
+    WHERE user_id IS NOT NULL AND user_isbot AND rev_isrevert > 0 AND :'botpolicy' = 'separate'
+    GROUP BY GROUPING SETS ((page_id, rev_year, user_id), (page_id, user_id), (page_id, rev_year, rev_period, user_id))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+), pageusersocialindices AS (
+    SELECT DISTINCT type, parent_id AS page_id, year, period, user_id
+    FROM articleusersocialindices JOIN w2o.pagetree USING (page_id)
+    UNION ALL
+    SELECT *
+    FROM articleusersocialindices
+)
+SELECT type, page_id, year, period, COUNT(*)::FLOAT AS weight
+FROM pageusersocialindices
+GROUP BY type, page_id, year, period;
+
+/*Timeweightsbyyear is a temporary table with the days of activity of every article, that is the days of the year or period within its first and last revision*/
+/*Years between the first and the last revision are all present, periods only if the article has revisions in them*/
+CREATE TABLE w2o.timeweightsbyyear AS
+WITH minmaxarticletimestamp AS (
+    SELECT page_id, MIN(rev_year) AS minyear, MAX(rev_year) AS maxyear,
+    MIN(rev_timestamp) AS mintimestamp, MAX(rev_timestamp) AS maxtimestamp
+    FROM w2o.revisions
+    GROUP BY page_id
+), spans AS (
+    SELECT page_id, year, '' AS period, make_date(year,1,1)::TIMESTAMP AS spanstart, make_date(year+1,1,1)::TIMESTAMP AS spanend
+    FROM minmaxarticletimestamp, generate_series(minyear,maxyear) _(year)
+    UNION ALL
+    SELECT page_id, 0 AS year, '' AS period, mintimestamp AS spanstart, maxtimestamp AS spanend
+    FROM minmaxarticletimestamp
+    UNION ALL
+    SELECT page_id, rev_year AS year, rev_period AS period, spanstart,
+    spanstart+(CASE :'granularity' WHEN 'quarter' THEN '3 months' ELSE '1 month' END)::INTERVAL AS spanend
+    FROM (
+        SELECT page_id, rev_year, rev_period, date_trunc(:'granularity', MIN(rev_timestamp)) AS spanstart
+        FROM w2o.revisions
+        WHERE rev_period IS NOT NULL
+        GROUP BY page_id, rev_year, rev_period
+    ) _
+)
+SELECT page_id, year, period,
+EXTRACT(epoch FROM (LEAST(maxtimestamp,spanend)-GREATEST(mintimestamp,spanstart)))/86400.0 AS weight
+FROM spans JOIN minmaxarticletimestamp USING (page_id);
+
+/*Define indicesbyyear table that for each page contains yearly and sub-year conflict and polemic statistic*/
+/*Confidence is popularity/(popularity+polemicprior) for article polemic and 1 otherwise*/
+/*Indices must defined in a way that missing entries correctly default to 0.0*/
+/*Sub-year entries are sparse instead: pages without activity in a period are missing, so they are ranked among the pages active in the period*/
+CREATE TABLE w2o.indicesbyyear AS
+WITH pageusersocialindicescount AS (
+    SELECT *
+    FROM w2o.socialcountsbyyear
+), pairedarticlesocialindicescount AS (
+    SELECT page_id, year, period, p1.weight AS popularity, p2.weight AS conflict
+    FROM w2o.pages JOIN pageusersocialindicescount p1 USING (page_id)
+    JOIN pageusersocialindicescount p2 USING (page_id, year, period)
+    WHERE p1.type IS NULL AND p2.type = 'conflict'::w2o.myindex AND page_type = 'article'::w2o.mypagetype
+), SparseEQPopularityEQConflict AS (
+    SELECT year, period, popularity, conflict, COUNT(*) as count
+    FROM pairedarticlesocialindicescount
+    GROUP BY year, period, popularity, conflict
+), Popularity AS (
+    SELECT DISTINCT year, period, popularity
+    FROM SparseEQPopularityEQConflict
+), Conflict AS (
+    SELECT DISTINCT year, period, conflict
+    FROM SparseEQPopularityEQConflict
+),
+/*Total page count is the number of articles created until the end of the year or period, period labels sort as the periods*/
+articlecreations AS (
+    SELECT CASE :'granularity' WHEN 'quarter' THEN to_char(page_creation, 'YYYY-"Q"Q') ELSE to_char(page_creation, 'YYYY-MM') END AS period, COUNT(*) AS created
+    FROM w2o.pagecreations JOIN w2o.pages USING (page_id)
+    WHERE page_type = 'article'::w2o.mypagetype AND :'granularity' != 'year'
+    GROUP BY 1
+), articlecountyears AS (
+    SELECT _.year, '' AS period, COUNT(*)::FLOAT AS totalpagecount
+    FROM w2o.timebounds, w2o.pages, generate_series(page_creationyear,maxyear) _(year)
+    WHERE page_type = 'article'::w2o.mypagetype
+    GROUP BY _.year
+    UNION ALL
+    SELECT 0 AS year, '' AS period, COUNT(*)::FLOAT AS totalpagecount
+    FROM w2o.pages
+    WHERE page_type = 'article'::w2o.mypagetype
+    UNION ALL
+    SELECT year, period, (SUM(COALESCE(created,0)) OVER (ORDER BY period))::FLOAT AS totalpagecount
+    FROM articlecreations FULL JOIN (SELECT DISTINCT year, period FROM Popularity WHERE period != '') _ USING (period)
+), EQPopularityEQConflict AS (
+    SELECT year, period, popularity, conflict, COALESCE(count,0) AS count
+    FROM Popularity JOIN Conflict USING (year, period)
+    LEFT JOIN SparseEQPopularityEQConflict USING (year, period, popularity, conflict)
+), EQPopularityGEConflict AS (
+    SELECT year, period, popularity, conflict,
+    SUM(count) OVER (PARTITION BY popularity, year, period ORDER BY conflict DESC) as count
+    FROM EQPopularityEQConflict
+), LEPopularityGEConflict AS (
+    SELECT year, period, popularity, conflict,
+    SUM(count) OVER (PARTITION BY conflict, year, period ORDER BY popularity) as count
+    FROM EQPopularityGEConflict
+), untimedarticlespolemic AS (
+    SELECT page_id, year, period, (conflict/popularity)*log(totalpagecount/count) AS weight
+    FROM pairedarticlesocialindicescount
+    JOIN LEPopularityGEConflict USING (year, period, popularity, conflict)
+    JOIN articlecountyears USING (year, period)
+),
+timeweights AS (
+    SELECT *
+    FROM w2o.timeweightsbyyear
+),
+/*Articlesactivity counts in one pass the revisions of every article by year and period, with the anonymous, bot and reverted anonymous ones*/
+articlesactivity AS (
+    SELECT page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, COUNT(*) AS revisions,
+    COUNT(*) FILTER (WHERE user_id IS NULL) AS anonymous,
+    COUNT(*) FILTER (WHERE user_isbot) AS bot,
+    COUNT(*) FILTER (WHERE user_id IS NULL AND rev_isreverted) AS anonymousrevert
+    FROM w2o.revisions
+    GROUP BY GROUPING SETS ((page_id, rev_year), (page_id), (page_id, rev_year, rev_period))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+),
+/*Articles with less than polemicminusers editors or polemicminrevisions revisions in the year or period are not polemic*/
+articlespolemic AS (
+    SELECT 'polemic'::w2o.myindex AS type, page_id, year, period, ap.weight*tw.weight AS weight
+    FROM untimedarticlespolemic ap JOIN timeweights tw USING (page_id, year, period)
+    JOIN pairedarticlesocialindicescount USING (page_id, year, period)
+    JOIN articlesactivity USING (page_id, year, period)
+    WHERE popularity >= :'polemicminusers' AND revisions >= :'polemicminrevisions'
+), articlespolemicconfidence AS (
+    SELECT 'polemic'::w2o.myindex AS type, page_id, year, period, popularity/(popularity + :'polemicprior') AS confidence
+    FROM pairedarticlesocialindicescount
+),
+/*Edit war is the number of mutual reverts*/
+articleseditwar AS (
+    SELECT 'editwar'::w2o.myindex AS type, page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, COUNT(*)::FLOAT AS weight
+    FROM w2o.editwars
+    GROUP BY GROUPING SETS ((page_id, rev_year), (page_id), (page_id, rev_year, rev_period))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+),
+/*Newcomer revert is the share of first edits of users that got reverted, articles with less than shareminactivity first edits in the year or period have none*/
+/*Topic counts are the sums of the counts of their articles*/
+articlesnewcomers AS (
+    SELECT page_id, COALESCE(rev_year, 0) AS year, COALESCE(rev_period, '') AS period, COUNT(*) AS newcomers, COUNT(*) FILTER (WHERE rev_isreverted) AS reverted
+    FROM w2o.newcomers
+    GROUP BY GROUPING SETS ((page_id, rev_year), (page_id), (page_id, rev_year, rev_period))
+    HAVING GROUPING(rev_period) = 1 OR rev_period IS NOT NULL
+), pagesnewcomerrevert AS (
+    SELECT 'newcomerrevert'::w2o.myindex AS type, page_id, year, period, reverted::FLOAT/newcomers::FLOAT AS weight
+    FROM articlesnewcomers
+    WHERE newcomers >= :'shareminactivity'
+    UNION ALL
+    SELECT 'newcomerrevert'::w2o.myindex AS type, parent_id AS page_id, year, period, SUM(reverted)::FLOAT/SUM(newcomers)::FLOAT AS weight
+    FROM articlesnewcomers JOIN w2o.pagetree USING (page_id)
+    GROUP BY parent_id, year, period
+),
+/*Anonymous and bot are the shares of anonymous and bot revisions, articles with less than shareminactivity revisions in the year or period have none*/
+/*Anonymous revert is the number of reverted anonymous revisions; topic counts are the sums of the counts of their articles*/
+pagesactivity AS (
+    SELECT * FROM articlesactivity
+    UNION ALL
+    SELECT parent_id AS page_id, year, period, SUM(revisions), SUM(anonymous), SUM(bot), SUM(anonymousrevert)
+    FROM articlesactivity JOIN w2o.pagetree USING (page_id)
+    GROUP BY parent_id, year, period
+), pagessharedactivity AS (
+    SELECT page_id, year, period, anonymous::FLOAT/revisions::FLOAT AS anonymous, bot::FLOAT/revisions::FLOAT AS bot
+    FROM pagesactivity JOIN w2o.pages USING (page_id)
+    WHERE page_type != 'article'::w2o.mypagetype OR revisions >= :'shareminactivity'
+),
+indices AS (
+    SELECT *
+    FROM pageusersocialindicescount
+    WHERE type IS NOT NULL
+    UNION ALL
+    SELECT * FROM articlespolemic
+    UNION ALL
+    SELECT 'polemic'::w2o.myindex AS type, parent_id AS page_id, year, period, SUM(weight) AS weight
+    FROM articlespolemic JOIN w2o.pagetree USING (page_id)
+    GROUP BY parent_id, year, period
+    UNION ALL
+    SELECT * FROM articleseditwar
+    UNION ALL
+    SELECT type, parent_id AS page_id, year, period, SUM(weight) AS weight
+    FROM articleseditwar JOIN w2o.pagetree USING (page_id)
+    GROUP BY type, parent_id, year, period
+    UNION ALL
+    SELECT * FROM pagesnewcomerrevert
+    UNION ALL
+    SELECT 'anonymous'::w2o.myindex AS type, page_id, year, period, anonymous AS weight FROM pagessharedactivity
+    UNION ALL
+    SELECT 'bot'::w2o.myindex AS type, page_id, year, period, bot AS weight FROM pagessharedactivity
+    UNION ALL
+    SELECT 'anonymousrevert'::w2o.myindex AS type, page_id, year, period, anonymousrevert::FLOAT AS weight FROM pagesactivity
+),
+types AS (
+    SELECT DISTINCT type, page_type
+    FROM indices JOIN w2o.pages USING (page_id)
+), typepageyear AS (
+    SELECT type, page_id, _.year, '' AS period
+    FROM w2o.pages JOIN types USING (page_type),
+    w2o.timebounds, generate_series(page_creationyear,maxyear) _(year)
+    UNION ALL
+    SELECT type, page_id, 0 AS year, '' AS period
+    FROM w2o.pages JOIN types USING (page_type)
+)
+SELECT type, page_id, parent_id AS topic_id, page_type, year, period, COALESCE(weight,0) AS weight, COALESCE(confidence,1) AS confidence
+FROM indices FULL JOIN typepageyear USING (type, page_id, year, period)
+JOIN w2o.pages USING (page_id)
+LEFT JOIN articlespolemicconfidence USING (type, page_id, year, period);
+
+/*Rawcountsbyyear contains the activity from which indices are computed: popularity (distinct editors), conflict (distinct reverters) and, for articles, time weight (days of activity)*/
+/*Entries are sparse: years without activity are missing, periods are not counted*/
+CREATE TABLE w2o.rawcountsbyyear AS
+WITH popularity AS (
+    SELECT page_id, year, weight AS popularity
+    FROM w2o.socialcountsbyyear
+    WHERE type IS NULL AND period = ''
+), conflict AS (
+    SELECT page_id, year, weight AS conflict
+    FROM w2o.socialcountsbyyear
+    WHERE type = 'conflict'::w2o.myindex AND period = ''
+), timeweights AS (
+    SELECT page_id, year, weight AS timeweight
+    FROM w2o.timeweightsbyyear
+    WHERE period = ''
+)
+SELECT page_id, year, COALESCE(popularity,0) AS popularity, COALESCE(conflict,0) AS conflict, COALESCE(timeweight,0) AS timeweight
+FROM popularity FULL JOIN conflict USING (page_id, year)
+FULL JOIN timeweights USING (page_id, year);
+DROP TABLE w2o.socialcountsbyyear;
+DROP TABLE w2o.timeweightsbyyear;
@@ -0,0 +1,30 @@
+/*Qualitychecks contains, for each data quality check, the count of faulty items over the checked ones; it must be computed before the revisions table is dropped*/
+/*Articles without revisions are counted upon import, before they are dropped*/
+CREATE TABLE w2o.qualitychecks AS
+SELECT 'articles-without-revisions' AS name, 'Articles with no revisions' AS description, faulty, total
+FROM w2o.revisionlessarticles
+UNION ALL
+SELECT 'topics-without-articles', 'Topics with no articles',
+COUNT(*) FILTER (WHERE NOT EXISTS (SELECT 1 FROM w2o.pages a WHERE a.parent_id = t.page_id AND a.page_type = 'article'::w2o.mypagetype)), COUNT(*)
+FROM w2o.pages t WHERE page_type = 'topic'::w2o.mypagetype
+UNION ALL
+SELECT 'missing-abstracts', 'Articles with a missing abstract',
+COUNT(*) FILTER (WHERE COALESCE(page_abstract, '') = ''), COUNT(*)
+FROM w2o.pages WHERE page_type = 'article'::w2o.mypagetype
+UNION ALL
+SELECT 'empty-socialjumps', 'Articles with no social jumps',
+COUNT(*) FILTER (WHERE cardinality(page_socialjumps) = 0), COUNT(*)
+FROM w2o.pages WHERE page_type = 'article'::w2o.mypagetype
+UNION ALL
+SELECT 'revisions-before-wikipedia', 'Revisions dated before the birth of Wikipedia',
+COUNT(*) FILTER (WHERE rev_timestamp < '2001-01-15'), COUNT(*)
+FROM w2o.revisions
+UNION ALL
+SELECT 'revisions-in-future', 'Revisions dated in the future',
+COUNT(*) FILTER (WHERE rev_timestamp > now()), COUNT(*)
+FROM w2o.revisions
+UNION ALL
+SELECT 'timebounds', 'Impossible or missing timebounds',
+COUNT(*) FILTER (WHERE minyear IS NULL OR minyear < 2001 OR maxtimestamp > now() OR mintimestamp > maxtimestamp), COUNT(*)
+FROM w2o.timebounds;
+DROP TABLE w2o.revisionlessarticles;
@@ -0,0 +1,18 @@
+/*Define the query used for exporting articles yealy top tens among the articles created in the same year*/
+WITH rankedarticles AS (
+    SELECT year, type, page_cohort AS cohort, page_id, weight,
+    row_number() OVER (PARTITION BY type, year, page_cohort ORDER BY weight DESC, page_id) AS rank
+    FROM w2o.indicesbyyear JOIN w2o.pages p USING (page_id)
+    WHERE p.page_type = 'article'::w2o.mypagetype AND page_cohort IS NOT NULL AND period = ''
+), top10 AS (
+    SELECT year, type, cohort, array_agg(CAST((p.page_id, p.page_title, p.page_abstract, p.parent_id, p.page_type, p.page_creationyear) AS w2o.page) ORDER BY rank) AS pages
+    FROM rankedarticles JOIN w2o.pages p USING (page_id)
+    WHERE rank <= 10
+    GROUP BY year, type, cohort
+), yearjson AS (
+    SELECT year, cohort, row_to_json(CAST((year, cohort, array_agg(CAST((type, pages) AS w2o.indexranking) ORDER BY type)) AS w2o.annualcohortindexesranking)) AS json
+    FROM top10
+    GROUP BY year, cohort
+) SELECT json
+FROM yearjson
+ORDER BY year, cohort;
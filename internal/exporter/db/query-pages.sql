@@ -0,0 +1,16 @@
+/*Define the query used for exporting informations for articles, topics and global, whose page ID is in the range [$1,$2)*/
+SELECT row_to_json(CAST((
+    CAST((page_id, page_title, page_abstract, parent_id, page_type, page_creationyear) AS w2o.page),
+    COALESCE(stats,array[]::w2o.indextype2measurements[]),
+    COALESCE(socialjumps,array[]::w2o.page[]),
+    COALESCE(periodstats,array[]::w2o.indextype2periodmeasurements[]),
+    COALESCE(rawcounts,array[]::w2o.rawcount[])
+) AS w2o.pageinfo))
+FROM w2o.pages p LEFT JOIN LATERAL (
+    SELECT array_agg(CAST((page_id, page_title, page_abstract, parent_id, page_type, page_creationyear) AS w2o.page) ORDER BY nr) AS socialjumps
+    FROM unnest(p.page_socialjumps) WITH ORDINALITY _(page_id, nr) JOIN w2o.pages USING (page_id)
+) _ ON TRUE
+JOIN w2o.pagestats USING (page_id)
+LEFT JOIN w2o.pagerawcounts USING (page_id)
+WHERE p.page_id >= $1 AND p.page_id < $2
+ORDER BY p.page_id;
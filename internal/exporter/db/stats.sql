@@ -0,0 +1,16 @@
+/*Datastats contains the statistics of the imported data, it must be computed before the revisions table is dropped*/
+CREATE TABLE w2o.datastats AS
+SELECT articles, topics, articleswithsocialjumps, revisions, botrevisions, bots, anonymousrevisions, users
+FROM (
+    SELECT COUNT(*) FILTER (WHERE page_type = 'article'::w2o.mypagetype) AS articles,
+    COUNT(*) FILTER (WHERE page_type = 'topic'::w2o.mypagetype) AS topics,
+    COUNT(*) FILTER (WHERE page_type = 'article'::w2o.mypagetype AND cardinality(page_socialjumps) > 0) AS articleswithsocialjumps
+    FROM w2o.pages
+) p, (
+    SELECT COUNT(*) AS revisions,
+    COUNT(*) FILTER (WHERE user_isbot) AS botrevisions,
+    COUNT(DISTINCT user_id) FILTER (WHERE user_isbot) AS bots,
+    COUNT(*) FILTER (WHERE user_id IS NULL) AS anonymousrevisions,
+    COUNT(DISTINCT user_id) FILTER (WHERE NOT user_isbot OR :'botpolicy' = 'include') AS users
+    FROM w2o.revisions
+) r;
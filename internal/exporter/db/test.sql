@@ -0,0 +1,40 @@
+/*Analysis window is unbounded, unless specified with -v windowfrom=... -v windowto=...*/
+/*An unset variable keeps its own name, e.g. :windowfrom, which is replaced by the default (psql 9 has no \if)*/
+\set windowfrom :windowfrom
+\set windowto :windowto
+SELECT CASE WHEN :'windowfrom' = ':windowfrom' THEN '' ELSE :'windowfrom' END AS windowfrom,
+CASE WHEN :'windowto' = ':windowto' THEN '' ELSE :'windowto' END AS windowto \gset
+/*Granularity of the period statistics is year (none), quarter or month, unless specified with -v granularity=...*/
+\set granularity :granularity
+SELECT CASE WHEN :'granularity' = ':granularity' THEN 'year' ELSE :'granularity' END AS granularity \gset
+/*Bots are excluded from the indices, unless specified with -v botpolicy=...*/
+\set botpolicy :botpolicy
+SELECT CASE WHEN :'botpolicy' = ':botpolicy' THEN 'exclude' ELSE :'botpolicy' END AS botpolicy \gset
+/*Polemic ranks every article with full confidence, unless specified with -v polemicminusers=... -v polemicminrevisions=... -v polemicprior=...*/
+\set polemicminusers :polemicminusers
+\set polemicminrevisions :polemicminrevisions
+\set polemicprior :polemicprior
+SELECT CASE WHEN :'polemicminusers' = ':polemicminusers' THEN '0' ELSE :'polemicminusers' END AS polemicminusers,
+CASE WHEN :'polemicminrevisions' = ':polemicminrevisions' THEN '0' ELSE :'polemicminrevisions' END AS polemicminrevisions,
+CASE WHEN :'polemicprior' = ':polemicprior' THEN '0' ELSE :'polemicprior' END AS polemicprior \gset
+/*Share indices need 10 revisions or first edits of an article in a year, unless specified with -v shareminactivity=...*/
+\set shareminactivity :shareminactivity
+SELECT CASE WHEN :'shareminactivity' = ':shareminactivity' THEN '10' ELSE :'shareminactivity' END AS shareminactivity \gset
+
+/*Load database*/
+\i base.sql;
+\i indices.sql;
+\i stats.sql;
+\i quality.sql;
+
+/*Disable pager for testing queries*/
+\pset pager off
+
+/*Test queries on database*/
+\i types.sql;
+\i query-toptenbyyear.sql;
+\i query-cohorttoptenbyyear.sql;
+/*The pages query is parametrized over a page ID range, test it over every page*/
+\set querypages `cat query-pages.sql`
+PREPARE querypages(INTEGER, INTEGER) AS :querypages
+EXECUTE querypages(0, 2147483647);
\ No newline at end of file
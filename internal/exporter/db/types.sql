@@ -0,0 +1,168 @@
+/*Free space since revisions table will not be anymore useful and indexes will take a lot of space*/
+DROP TABLE w2o.revisions;
+DROP TABLE w2o.editwars;
+DROP TABLE w2o.newcomers;
+DROP TABLE w2o.pagecreations;
+
+/*Define indexes over indicesbyyear*/
+CREATE INDEX ON w2o.indicesbyyear (page_id);
+/*Used by LATERAL JOIN in queries*/
+CREATE INDEX ON w2o.indicesbyyear (weight DESC, year, period, topic_id, type, page_type);
+ANALYZE w2o.indicesbyyear;
+
+
+/*The following types are used by the pages and toptenbyyear queries*/
+
+CREATE TYPE w2o.yearmeasurement AS (
+    Value                 FLOAT,
+    Percentile            FLOAT,
+    DensePercentile       FLOAT,
+    Rank                  INTEGER,
+    TopicPercentile       FLOAT,
+    TopicDensePercentile  FLOAT,
+    TopicRank             INTEGER,
+    Confidence            FLOAT,
+    ZScore                FLOAT,
+    LogZScore             FLOAT,
+    TopicZScore           FLOAT,
+    TopicLogZScore        FLOAT,
+    CohortPercentile      FLOAT,
+    CohortDensePercentile FLOAT,
+    CohortRank            INTEGER,
+    CohortZScore          FLOAT,
+    CohortLogZScore       FLOAT,
+    Year                  INTEGER
+);
+
+CREATE TYPE w2o.indextype2measurements AS (
+    IndexType             w2o.myindex,
+    Measurements          w2o.yearmeasurement[]
+);
+
+CREATE TYPE w2o.page AS (
+    ID                    INTEGER,
+    Title                 VARCHAR(512),
+    Abstract              TEXT,
+    ParentID              INTEGER,
+    Type                  w2o.mypagetype,
+    CreationYear          INTEGER
+);
+
+CREATE TYPE w2o.periodmeasurement AS (
+    Value                 FLOAT,
+    Percentile            FLOAT,
+    DensePercentile       FLOAT,
+    Rank                  INTEGER,
+    TopicPercentile       FLOAT,
+    TopicDensePercentile  FLOAT,
+    TopicRank             INTEGER,
+    Confidence            FLOAT,
+    ZScore                FLOAT,
+    LogZScore             FLOAT,
+    TopicZScore           FLOAT,
+    TopicLogZScore        FLOAT,
+    CohortPercentile      FLOAT,
+    CohortDensePercentile FLOAT,
+    CohortRank            INTEGER,
+    CohortZScore          FLOAT,
+    CohortLogZScore       FLOAT,
+    Period                TEXT
+);
+
+CREATE TYPE w2o.indextype2periodmeasurements AS (
+    IndexType             w2o.myindex,
+    Measurements          w2o.periodmeasurement[]
+);
+
+CREATE TYPE w2o.rawcount AS (
+    Popularity            FLOAT,
+    Conflict              FLOAT,
+    TimeWeight            FLOAT,
+    Year                  INTEGER
+);
+
+CREATE TYPE w2o.pageinfo  AS (
+    Page                  w2o.page,
+    Stats                 w2o.indextype2measurements[],
+    Links                 w2o.page[],
+    PeriodStats           w2o.indextype2periodmeasurements[],
+    RawCounts             w2o.rawcount[]
+);
+
+CREATE TYPE w2o.indexranking AS (
+    Index                 w2o.myindex,
+    Ranking               w2o.page[]
+);
+
+CREATE TYPE w2o.annualindexesranking AS (
+    Year                  INTEGER,
+    IndexesRanking        w2o.indexranking[]
+);
+
+CREATE TYPE w2o.annualcohortindexesranking AS (
+    Year                  INTEGER,
+    Cohort                INTEGER,
+    IndexesRanking        w2o.indexranking[]
+);
+
+
+/*Pagestats contains the precomputed yearly and sub-year statistics of every page, used by the pages query*/
+/*Z-scores are 0 when all values are equal, log z-scores are computed over ln(1+value) so that heavy tails do not dominate them*/
+/*Cohort statistics compare an article with the articles created in the same year, they are NULL for articles without cohort*/
+/*Sub-year statistics are computed as the yearly ones, among the pages active in the period*/
+CREATE TABLE w2o.pagestats AS
+WITH logindices AS (
+    SELECT i.*, p.page_cohort, ln(1 + GREATEST(weight,0)) AS logweight
+    FROM w2o.indicesbyyear i JOIN w2o.pages p USING (page_id)
+), percentiledindices AS (
+    SELECT type, page_id, year, period, weight, confidence,
+    COALESCE((weight - avg(weight) OVER wz)/NULLIF(stddev_pop(weight) OVER wz, 0), 0) AS zscore,
+    COALESCE((logweight - avg(logweight) OVER wz)/NULLIF(stddev_pop(logweight) OVER wz, 0), 0) AS logzscore,
+    COALESCE((weight - avg(weight) OVER twz)/NULLIF(stddev_pop(weight) OVER twz, 0), 0) AS topiczscore,
+    COALESCE((logweight - avg(logweight) OVER twz)/NULLIF(stddev_pop(logweight) OVER twz, 0), 0) AS topiclogzscore,
+    percent_rank() OVER w AS percentile,
+    (dense_rank() OVER w - 1.0)/GREATEST((dense_rank() OVER wd + dense_rank() OVER w - 2),1) AS dense_percentile,
+    rank() OVER wd AS rank,
+    (dense_rank() OVER tw - 1.0)/GREATEST((dense_rank() OVER twd + dense_rank() OVER tw - 2),1) AS topic_dense_percentile,
+    percent_rank() OVER tw AS topic_percentile,
+    rank() OVER twd AS topic_rank,
+    CASE WHEN page_cohort IS NOT NULL THEN percent_rank() OVER cw END AS cohort_percentile,
+    CASE WHEN page_cohort IS NOT NULL THEN (dense_rank() OVER cw - 1.0)/GREATEST((dense_rank() OVER cwd + dense_rank() OVER cw - 2),1) END AS cohort_dense_percentile,
+    CASE WHEN page_cohort IS NOT NULL THEN rank() OVER cwd END AS cohort_rank,
+    CASE WHEN page_cohort IS NOT NULL THEN COALESCE((weight - avg(weight) OVER cwz)/NULLIF(stddev_pop(weight) OVER cwz, 0), 0) END AS cohortzscore,
+    CASE WHEN page_cohort IS NOT NULL THEN COALESCE((logweight - avg(logweight) OVER cwz)/NULLIF(stddev_pop(logweight) OVER cwz, 0), 0) END AS cohortlogzscore
+    FROM logindices
+    WINDOW w AS (PARTITION BY type, year, period, page_type ORDER BY weight),
+    wd AS (PARTITION BY type, year, period, page_type ORDER BY weight DESC),
+    tw AS (PARTITION BY type, year, period, page_type, topic_id ORDER BY weight),
+    twd AS (PARTITION BY type, year, period, page_type, topic_id ORDER BY weight DESC),
+    wz AS (PARTITION BY type, year, period, page_type),
+    twz AS (PARTITION BY type, year, period, page_type, topic_id),
+    cw AS (PARTITION BY type, year, period, page_type, page_cohort ORDER BY weight),
+    cwd AS (PARTITION BY type, year, period, page_type, page_cohort ORDER BY weight DESC),
+    cwz AS (PARTITION BY type, year, period, page_type, page_cohort)
+), percentiledindicesagg AS (
+    SELECT page_id, type,
+    array_agg(CAST((weight, percentile, dense_percentile, rank, topic_percentile, topic_dense_percentile, topic_rank, confidence, zscore, logzscore, topiczscore, topiclogzscore, cohort_percentile, cohort_dense_percentile, cohort_rank, cohortzscore, cohortlogzscore, year) AS w2o.yearmeasurement) ORDER BY year ASC) FILTER (WHERE period = '') AS measurements,
+    array_agg(CAST((weight, percentile, dense_percentile, rank, topic_percentile, topic_dense_percentile, topic_rank, confidence, zscore, logzscore, topiczscore, topiclogzscore, cohort_percentile, cohort_dense_percentile, cohort_rank, cohortzscore, cohortlogzscore, period) AS w2o.periodmeasurement) ORDER BY period ASC) FILTER (WHERE period != '') AS periodmeasurements
+    FROM percentiledindices
+    GROUP BY page_id, type
+)
+SELECT page_id,
+array_agg(CAST((type, measurements) AS w2o.indextype2measurements) ORDER BY type ASC) FILTER (WHERE measurements IS NOT NULL) AS stats,
+array_agg(CAST((type, periodmeasurements) AS w2o.indextype2periodmeasurements) ORDER BY type ASC) FILTER (WHERE periodmeasurements IS NOT NULL) AS periodstats
+FROM percentiledindicesagg
+GROUP BY page_id;
+
+ALTER TABLE w2o.pagestats ADD PRIMARY KEY (page_id);
+ANALYZE w2o.pagestats;
+
+/*Pagerawcounts contains the yearly raw counts of every page, used by the pages query*/
+CREATE TABLE w2o.pagerawcounts AS
+SELECT page_id, array_agg(CAST((popularity, conflict, timeweight, year) AS w2o.rawcount) ORDER BY year ASC) AS rawcounts
+FROM w2o.rawcountsbyyear
+GROUP BY page_id;
+DROP TABLE w2o.rawcountsbyyear;
+
+ALTER TABLE w2o.pagerawcounts ADD PRIMARY KEY (page_id);
+ANALYZE w2o.pagerawcounts;
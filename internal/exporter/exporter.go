@@ -2,7 +2,9 @@ package exporter
 
 import (
 	"context"
+	"fmt"
 	"html/template"
+	"io"
 	"net/url"
 	"path/filepath"
 	"strings"
@@ -14,18 +16,85 @@ import (
 	"github.com/pkg/errors"
 )
 
-//Download database structure
-//go:generate rm -fr wiki2overpediadb
-//go:generate git clone https://github.com/negapedia/wiki2overpediadb.git
-//go:generate mv wiki2overpediadb/db/ db/
+//Database structure lives in db/, it was forked from https://github.com/negapedia/wiki2overpediadb
 
 //Regenerate bindata
 //go:generate go-bindata -pkg $GOPACKAGE db/... templates/...
 
-//Delete everything created or downloaded
-//go:generate rm -fr wiki2overpediadb db
+//Window restricts the analysis to the revisions from From (included) to To (excluded), zero bounds are unbounded.
+type Window struct {
+	From, To time.Time
+}
+
+func (w Window) sqlBounds() (from, to string) {
+	format := func(t time.Time) string {
+		if t.IsZero() {
+			return "''"
+		}
+		return "'" + t.UTC().Format("2006-01-02 15:04:05") + "'"
+	}
+	return format(w.From), format(w.To)
+}
+
+//Granularity of the period statistics, Yearly means no period statistics besides the yearly ones.
+type Granularity string
+
+const (
+	Yearly    Granularity = "year"
+	Quarterly Granularity = "quarter"
+	Monthly   Granularity = "month"
+)
+
+//Check returns an error if g is not supported.
+func (g Granularity) Check() error {
+	switch g {
+	case Yearly, Quarterly, Monthly:
+		return nil
+	}
+	return errors.New("error: granularity " + string(g) + " not supported")
+}
+
+//BotPolicy is the treatment of bots in the indices and in the statistics.
+type BotPolicy string
+
+const (
+	//IncludeBots counts bots as any other user.
+	IncludeBots BotPolicy = "include"
+	//ExcludeBots ignores bots, besides the bot share index.
+	ExcludeBots BotPolicy = "exclude"
+	//SeparateBots ignores bots as ExcludeBots, but counts reverting bots in the botconflict index.
+	SeparateBots BotPolicy = "separate"
+)
+
+//Check returns an error if p is not supported.
+func (p BotPolicy) Check() error {
+	switch p {
+	case IncludeBots, ExcludeBots, SeparateBots:
+		return nil
+	}
+	return errors.New("error: bot policy " + string(p) + " not supported")
+}
+
+//Thresholds tune the minimum activity of articles for their indices, below it they are 0.
+type Thresholds struct {
+	//PolemicMinUsers and PolemicMinRevisions are the minimum activity of an article in a year for it to be polemic.
+	PolemicMinUsers, PolemicMinRevisions int
+	//PolemicPrior is the number of editors at which polemic confidence is 0.5, 0 means full confidence.
+	PolemicPrior float64
+	//ShareMinActivity is the minimum number of revisions of an article in a year for its anonymous and bot shares,
+	//and of first edits for its newcomerrevert share.
+	ShareMinActivity int
+}
+
+//Check returns an error if t has negative values.
+func (t Thresholds) Check() error {
+	if t.PolemicMinUsers < 0 || t.PolemicMinRevisions < 0 || t.PolemicPrior < 0 || t.ShareMinActivity < 0 {
+		return errors.New("error: thresholds and prior must not be negative")
+	}
+	return nil
+}
 
-func From(ctx context.Context, db *sqlx.DB, lang, csvPath string, wwwURL, langURL url.URL, extDataChannels ...<-chan ExtData) (m Exporter, destructor func(), err error) {
+func From(ctx context.Context, db *sqlx.DB, lang, csvPath string, window Window, granularity Granularity, bots BotPolicy, thresholds Thresholds, wwwURL, langURL url.URL, extDataChannels ...<-chan ExtData) (m Exporter, destructor func(), err error) {
 	csvPath, err = filepath.Abs(csvPath)
 	if err != nil {
 		err = errors.Wrap(err, "Error while converting source path to absolute")
@@ -38,16 +107,34 @@ func From(ctx context.Context, db *sqlx.DB, lang, csvPath string, wwwURL, langUR
 	}
 
 	query := ""
-	for _, dbfile := range []string{"db/base.sql", "db/indices.sql", "db/types.sql"} {
+	for _, dbfile := range []string{"db/base.sql", "db/indices.sql", "db/stats.sql", "db/quality.sql", "db/types.sql"} {
 		var b []byte
 		if b, err = Asset(dbfile); err != nil {
 			return fail(errors.Wrap(err, err.Error()+" while opening "+dbfile))
 		}
 		query += string(b)
 	}
-	for _, name := range []string{"pages", "revisions", "socialjumps"} {
+	for _, name := range []string{"pages", "revisions", "socialjumps", "editwars", "newcomers"} {
 		query = strings.Replace(query, ":'"+name+"filepath'", "'"+filepath.Join(csvPath, name)+".csv'", -1)
 	}
+	from, to := window.sqlBounds()
+	query = strings.Replace(query, ":'windowfrom'", from, -1)
+	query = strings.Replace(query, ":'windowto'", to, -1)
+	if err = granularity.Check(); err != nil {
+		return fail(err)
+	}
+	query = strings.Replace(query, ":'granularity'", "'"+string(granularity)+"'", -1)
+	if err = bots.Check(); err != nil {
+		return fail(err)
+	}
+	query = strings.Replace(query, ":'botpolicy'", "'"+string(bots)+"'", -1)
+	if err = thresholds.Check(); err != nil {
+		return fail(err)
+	}
+	query = strings.Replace(query, ":'polemicminusers'", fmt.Sprintf("'%d'", thresholds.PolemicMinUsers), -1)
+	query = strings.Replace(query, ":'polemicminrevisions'", fmt.Sprintf("'%d'", thresholds.PolemicMinRevisions), -1)
+	query = strings.Replace(query, ":'polemicprior'", fmt.Sprintf("'%g'", thresholds.PolemicPrior), -1)
+	query = strings.Replace(query, ":'shareminactivity'", fmt.Sprintf("'%d'", thresholds.ShareMinActivity), -1)
 
 	for _, query := range strings.Split(query, ";") {
 		if _, err = db.ExecContext(ctx, query); err != nil {
@@ -59,6 +146,7 @@ func From(ctx context.Context, db *sqlx.DB, lang, csvPath string, wwwURL, langUR
 	if err != nil {
 		return fail(err)
 	}
+	m.tmpDir = csvPath
 
 	return
 }
@@ -73,8 +161,9 @@ func Open(ctx context.Context, db *sqlx.DB, lang string, wwwURL, langURL url.URL
 	m.lang = lang
 	m.wwwURL, m.langURL = wwwURL, langURL
 	m.extDataChannels = extDataChannels
+	m.tmpDir = "."
 
-	err = db.GetContext(ctx, &m.boundingYears, "SELECT minyear AS Min, maxyear AS Max, mintimestamp AS MinTimestamp, maxtimestamp AS MaxTimestamp FROM w2o.timebounds;")
+	err = db.GetContext(ctx, &m.boundingYears, "SELECT minyear AS Min, maxyear AS Max, mintimestamp AS MinTimestamp, maxtimestamp AS MaxTimestamp, windowfrom AS WindowFrom, windowto AS WindowTo FROM w2o.timebounds;")
 	if err != nil {
 		return fail(errors.Wrap(err, "Error while retrieving Timebounds"))
 	}
@@ -96,9 +185,12 @@ type Exporter struct {
 	boundingYears   struct {
 		Min, Max                   int64
 		MinTimestamp, MaxTimestamp time.Time
+		WindowFrom, WindowTo       *time.Time
 	}
 	templates       *template.Template
 	extDataChannels []<-chan ExtData
+	tmpDir          string //where external data is spilled, the CSV folder of the run if m comes from From
+	faults          *Quarantine
 }
 
 func getDestructor(db *sqlx.DB) func() {
@@ -113,7 +205,7 @@ func (m Exporter) Everything(ctx context.Context, fail func(error) error) <-chan
 		defer close(out)
 		type FExporter func(context.Context, func(error) error, chan<- VFile)
 		var wg sync.WaitGroup
-		for _, f := range []FExporter{m.Pages, m.TopTens} {
+		for _, f := range []FExporter{m.Pages, m.TopTens, m.CohortTopTens} {
 			wg.Add(1)
 			go func(f FExporter) {
 				defer wg.Done()
@@ -126,8 +218,34 @@ func (m Exporter) Everything(ctx context.Context, fail func(error) error) <-chan
 	return out
 }
 
+//Kinds of VFile
+const (
+	PageFile   = "page"
+	TopTenFile = "topten"
+)
+
+//VFile is a virtual file, whose content is rendered upon request straight into the destination.
 type VFile struct {
-	Path, Data string
+	Path, Kind string
+	render     func(w io.Writer) error
+}
+
+//WriteTo renders the content of the file to w.
+func (f VFile) WriteTo(w io.Writer) (n int64, err error) {
+	cw := &countingWriter{Writer: w}
+	err = f.render(cw)
+	return cw.N, err
+}
+
+type countingWriter struct {
+	io.Writer
+	N int64
+}
+
+func (w *countingWriter) Write(p []byte) (n int, err error) {
+	n, err = w.Writer.Write(p)
+	w.N += int64(n)
+	return
 }
 
 func (m Exporter) Lang() string {
@@ -153,3 +271,22 @@ func (m Exporter) MinTimestamp() int64 {
 func (m Exporter) MaxTimestamp() int64 {
 	return m.boundingYears.MaxTimestamp.Unix()
 }
+
+//Window returns the label of the analysis window, empty if the whole history is analyzed.
+func (m Exporter) Window() string {
+	from, to := m.boundingYears.WindowFrom, m.boundingYears.WindowTo
+	if from == nil && to == nil {
+		return ""
+	}
+	label := func(t *time.Time) string {
+		if t == nil {
+			return "…"
+		}
+		return t.Format("2006-01-02")
+	}
+	if to != nil { //to is excluded
+		last := to.Add(-time.Nanosecond)
+		to = &last
+	}
+	return label(from) + " – " + label(to)
+}
@@ -0,0 +1,30 @@
+package exporter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowSQLBounds(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	tests := []struct {
+		name             string
+		window           Window
+		wantFrom, wantTo string
+	}{
+		{"unbounded", Window{}, "''", "''"},
+		{"from only", Window{From: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)}, "'2010-01-01 00:00:00'", "''"},
+		{"to only", Window{To: time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC)}, "''", "'2011-01-01 00:00:00'"},
+		{"both", Window{time.Date(2010, 3, 4, 5, 6, 7, 0, time.UTC), time.Date(2010, 3, 5, 0, 0, 0, 0, time.UTC)}, "'2010-03-04 05:06:07'", "'2010-03-05 00:00:00'"},
+		{"equal bounds", Window{time.Date(2010, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2010, 3, 4, 0, 0, 0, 0, time.UTC)}, "'2010-03-04 00:00:00'", "'2010-03-04 00:00:00'"},
+		{"other zone", Window{From: time.Date(2010, 1, 1, 0, 0, 0, 0, cet)}, "'2009-12-31 23:00:00'", "''"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.window.sqlBounds()
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("got %v and %v, want %v and %v", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
@@ -1,62 +1,196 @@
 package exporter
 
 import (
-	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"path"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/jmoiron/sqlx/types"
+	"github.com/negapedia/negapedia/internal/watchdog"
 	"github.com/pkg/errors"
 )
 
+//_PagesChunkSize is the width of the page ID ranges in which the pages export is partitioned.
+const _PagesChunkSize = 1 << 18
+
+//_PagesWorkers is the number of concurrent queries and renderers of the pages export.
+var _PagesWorkers = runtime.NumCPU()
+
 func (m Exporter) Pages(ctx context.Context, fail func(error) error, out chan<- VFile) {
 	query, err := Asset("db/query-pages.sql")
 	if err != nil {
 		fail(errors.Wrap(err, "Error while Retrieving Query asset"))
 		return
 	}
-	rows, err := m.db.QueryContext(ctx, string(query))
-	if err != nil {
+
+	var maxID uint32
+	if err = m.db.GetContext(ctx, &maxID, "SELECT MAX(page_id) FROM w2o.pages;"); err != nil {
 		fail(errors.Wrap(err, "Error while Quering"))
 		return
 	}
+
+	//Chunks of page IDs are assigned round robin to workers, external data is spilled by chunk so that workers do not wait for each other
+	chunks := int(maxID/_PagesChunkSize) + 1
+	workers := _PagesWorkers
+	if workers > chunks {
+		workers = chunks
+	}
+	var channel2ChunksExtData [][]*chunkExtData
+	if len(m.extDataChannels) > 0 {
+		tmpDir, err := ioutil.TempDir(m.tmpDir, ".extdata")
+		if err != nil {
+			fail(errors.Wrap(err, "Error while creating external data folder"))
+			return
+		}
+		defer os.RemoveAll(tmpDir)
+		channel2ChunksExtData = spillExtData(ctx, fail, m.extDataChannels, chunks, tmpDir)
+	}
+
+	progress := watchdog.Progress(ctx, "pages")
+	defer progress.Done()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for chunk := w; chunk < chunks; chunk += workers {
+				extDataChannels := make([]<-chan ExtData, len(channel2ChunksExtData))
+				for i, chunksExtData := range channel2ChunksExtData {
+					extDataChannels[i] = chunksExtData[chunk].load(ctx, fail)
+				}
+				if !m.pagesChunk(ctx, fail, string(query), chunk, extDataChannels, out, progress) {
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+}
+
+//pagesChunk exports the pages in the given chunk, loading them with data from extDataChannels. It returns false iff the export should stop.
+func (m Exporter) pagesChunk(ctx context.Context, fail func(error) error, query string, chunk int, extDataChannels []<-chan ExtData, out chan<- VFile, progress *watchdog.Counter) bool {
+	defer func() { //Discard external data of absent pages
+		for _, ch := range extDataChannels {
+			for range ch {
+			}
+		}
+	}()
+
+	rows, err := m.db.QueryContext(ctx, query, chunk*_PagesChunkSize, (chunk+1)*_PagesChunkSize)
+	if err != nil {
+		fail(errors.Wrap(err, "Error while Quering"))
+		return false
+	}
 	defer rows.Close()
 
-	loadExternalData := externalDataAdapter(ctx, fail, m.extDataChannels)
+	loadExternalData := externalDataAdapter(ctx, fail, extDataChannels)
 
 	var jsonText types.JSONText
 	for rows.Next() {
 		if err = rows.Scan(&jsonText); err != nil {
 			fail(errors.Wrap(err, "Error while Scanning"))
-			return
+			return false
 		}
 		i, err := m.jsonText2Info(jsonText)
 		if err != nil {
-			fail(err)
-			return
+			if err = m.jsonQuarantine(jsonText, "unmarshal", err); err != nil {
+				fail(err)
+				return false
+			}
+			continue
 		}
 		loadExternalData(&i)
 
-		templateName := "page.html"
-		if i.Page.Type == _homepage {
-			templateName = "homepage.html"
+		if i.Page.Type != _homepage && i.Page.Topic() == "" {
+			if err = m.quarantine(i.Page, "topic", errors.Errorf("Topic of page %v not found", i.Page.ID)); err != nil {
+				fail(err)
+				return false
+			}
+			continue
 		}
 
-		var b bytes.Buffer
-		if err = m.templates.ExecuteTemplate(&b, templateName, i); err != nil {
-			fail(errors.Wrap(err, "Error while executing template"))
-			return
+		render := func(w io.Writer) error {
+			err := m.Render(w, i)
+			if err == nil {
+				return nil
+			}
+			if err = m.quarantine(i.Page, "template", err); err != nil {
+				return err
+			}
+			return ErrQuarantined
 		}
 
 		select {
 		case <-ctx.Done():
-			return
-		case out <- VFile{i.FilePath(), string(b.Bytes())}:
-			//Go on
+			return false
+		case out <- VFile{i.FilePath(), PageFile, render}:
+			progress.Inc()
 		}
 	}
+	if err = rows.Err(); err != nil {
+		fail(errors.Wrap(err, "Error while Quering"))
+		return false
+	}
+
+	return true
+}
+
+//Page returns the Info of the page with the given ID, loaded with its external data.
+func (m Exporter) Page(ctx context.Context, fail func(error) error, ID uint32) (i Info, err error) {
+	query, err := Asset("db/query-pages.sql")
+	if err != nil {
+		err = errors.Wrap(err, "Error while Retrieving Query asset")
+		return
+	}
+
+	var jsonText types.JSONText
+	switch err = m.db.QueryRowContext(ctx, string(query), ID, int64(ID)+1).Scan(&jsonText); {
+	case err == sql.ErrNoRows:
+		err = errors.Errorf("Page %v not found", ID)
+		return
+	case err != nil:
+		err = errors.Wrap(err, "Error while Quering")
+		return
+	}
+
+	if i, err = m.jsonText2Info(jsonText); err != nil {
+		return
+	}
+	externalDataAdapter(ctx, fail, m.extDataChannels)(&i)
+
+	return
+}
+
+//PageID returns the ID of the page with the given title, articles take precedence over topics.
+func (m Exporter) PageID(ctx context.Context, title string) (ID uint32, err error) {
+	switch err = m.db.GetContext(ctx, &ID, "SELECT page_id FROM w2o.pages WHERE page_title = $1 ORDER BY page_type DESC LIMIT 1;", title); {
+	case err == sql.ErrNoRows:
+		err = errors.Errorf("Page %v not found", title)
+	case err != nil:
+		err = errors.Wrap(err, "Error while Quering")
+	}
+	return
+}
+
+//Render writes the webpage of i to w.
+func (m Exporter) Render(w io.Writer, i Info) error {
+	templateName := "page.html"
+	if i.Page.Type == _homepage {
+		templateName = "homepage.html"
+	}
+
+	if err := m.templates.ExecuteTemplate(w, templateName, i); err != nil {
+		return errors.Wrap(err, "Error while executing template")
+	}
+	return nil
 }
 
 func (m *Exporter) jsonText2Info(jsonText types.JSONText) (i Info, err error) {
@@ -66,6 +200,10 @@ func (m *Exporter) jsonText2Info(jsonText types.JSONText) (i Info, err error) {
 			Indextype    string
 			Measurements []YearMeasurement
 		}
+		PeriodStats []struct {
+			Indextype    string
+			Measurements []PeriodMeasurement
+		}
 	}{}
 	if err = jsonText.Unmarshal(&res); err != nil {
 		err = errors.Wrap(err, "Error while Unmarshalling")
@@ -81,6 +219,13 @@ func (m *Exporter) jsonText2Info(jsonText types.JSONText) (i Info, err error) {
 	res.Index2Measurement = index2Measurement
 	res.Index2YearMeasurements = index2YearMeasurements
 
+	if len(res.PeriodStats) > 0 {
+		res.Index2PeriodMeasurements = make(map[string][]PeriodMeasurement, len(res.PeriodStats))
+		for _, e := range res.PeriodStats {
+			res.Index2PeriodMeasurements[e.Indextype] = e.Measurements
+		}
+	}
+
 	res.Info.Exporter = m
 	res.Info.ExternalFields = map[string]interface{}{}
 
@@ -96,6 +241,14 @@ type Measurement struct {
 	Rank                                  int
 	TopicPercentile, TopicDensePercentile float64
 	TopicRank                             int
+	//Confidence in Value, from 0 to 1, it's below 1 only for article polemic with a positive prior
+	Confidence float64
+	//ZScore and LogZScore are the standard scores of Value and of ln(1+Value) among the pages of the same type, Topic ones among the pages of the same topic
+	ZScore, LogZScore, TopicZScore, TopicLogZScore float64
+	//Cohort ones are among the articles created in the same year, they are 0 for articles created before the analysis window
+	CohortPercentile, CohortDensePercentile float64
+	CohortRank                              int
+	CohortZScore, CohortLogZScore           float64
 }
 
 type YearMeasurement struct {
@@ -103,13 +256,32 @@ type YearMeasurement struct {
 	Year int
 }
 
+//PeriodMeasurement is a measurement over a sub-year period, such as 2016-Q3 or 2016-07, ranked among the pages active in the period.
+type PeriodMeasurement struct {
+	Measurement
+	Period string
+}
+
+//RawCount is the yearly activity from which the indices of a page are computed, Year 0 is the whole history.
+//RawCounts of Info are sorted by year, years without activity are missing.
+type RawCount struct {
+	//Popularity is the number of distinct editors, Conflict the number of distinct reverters
+	Popularity, Conflict float64
+	//TimeWeight is the number of days between the first and last revision of the year, 0 for topics and global
+	TimeWeight float64
+	Year       int
+}
+
 type Info struct {
 	*Exporter
 	Page                   Page
 	Index2Measurement      map[string]Measurement
 	Index2YearMeasurements map[string][]YearMeasurement
-	Links                  []Page
-	ExternalFields         map[string]interface{}
+	RawCounts              []RawCount
+	//Index2PeriodMeasurements is nil if the granularity is yearly, periods without measurements are missing
+	Index2PeriodMeasurements map[string][]PeriodMeasurement
+	Links                    []Page
+	ExternalFields           map[string]interface{}
 }
 
 func (i Info) FilePath() string {
@@ -138,7 +310,8 @@ type ranking struct {
 	Rank                        int
 	percentile, densePercentile float64
 	Index, Among, Span          string
-	Value                       float64
+	Value, ZScore, LogZScore    float64
+	Confidence                  float64
 }
 
 func (r ranking) Percentile() int {
@@ -151,12 +324,12 @@ func (r ranking) DensePercentile() int {
 
 func (i Info) Rankings() (rankings []ranking) {
 	for index, amm := range i.Index2Measurement {
-		rankings = append(rankings, ranking{amm.Rank, amm.Percentile, amm.DensePercentile, index, "all", "all", amm.Value})
+		rankings = append(rankings, ranking{amm.Rank, amm.Percentile, amm.DensePercentile, index, "all", "all", amm.Value, amm.ZScore, amm.LogZScore, amm.Confidence})
 	}
 	for index, ymm := range i.Index2YearMeasurements {
 		for _, ym := range ymm {
 			year := fmt.Sprint(ym.Year)
-			rankings = append(rankings, ranking{ym.Rank, ym.Percentile, ym.DensePercentile, index, "all", year, ym.Value})
+			rankings = append(rankings, ranking{ym.Rank, ym.Percentile, ym.DensePercentile, index, "all", year, ym.Value, ym.ZScore, ym.LogZScore, ym.Confidence})
 		}
 	}
 
@@ -165,12 +338,30 @@ func (i Info) Rankings() (rankings []ranking) {
 	}
 
 	for index, amm := range i.Index2Measurement {
-		rankings = append(rankings, ranking{amm.TopicRank, amm.TopicPercentile, amm.TopicDensePercentile, index, i.Page.Topic(), "all", amm.Value})
+		rankings = append(rankings, ranking{amm.TopicRank, amm.TopicPercentile, amm.TopicDensePercentile, index, i.Page.Topic(), "all", amm.Value, amm.TopicZScore, amm.TopicLogZScore, amm.Confidence})
 	}
 	for index, ymm := range i.Index2YearMeasurements {
 		for _, ym := range ymm {
 			year := fmt.Sprint(ym.Year)
-			rankings = append(rankings, ranking{ym.TopicRank, ym.TopicPercentile, ym.TopicDensePercentile, index, i.Page.Topic(), year, ym.Value})
+			rankings = append(rankings, ranking{ym.TopicRank, ym.TopicPercentile, ym.TopicDensePercentile, index, i.Page.Topic(), year, ym.Value, ym.TopicZScore, ym.TopicLogZScore, ym.Confidence})
+		}
+	}
+
+	//CohortRank is 0 for articles created before the analysis window, which have no cohort
+	cohort := fmt.Sprint("cohort ", i.Page.CreationYear)
+	for index, amm := range i.Index2Measurement {
+		if amm.CohortRank == 0 {
+			continue
+		}
+		rankings = append(rankings, ranking{amm.CohortRank, amm.CohortPercentile, amm.CohortDensePercentile, index, cohort, "all", amm.Value, amm.CohortZScore, amm.CohortLogZScore, amm.Confidence})
+	}
+	for index, ymm := range i.Index2YearMeasurements {
+		for _, ym := range ymm {
+			if ym.CohortRank == 0 {
+				continue
+			}
+			year := fmt.Sprint(ym.Year)
+			rankings = append(rankings, ranking{ym.CohortRank, ym.CohortPercentile, ym.CohortDensePercentile, index, cohort, year, ym.Value, ym.CohortZScore, ym.CohortLogZScore, ym.Confidence})
 		}
 	}
 	return
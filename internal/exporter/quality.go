@@ -0,0 +1,101 @@
+package exporter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//DefaultChecks maps each data quality check to its threshold: the maximum tolerated fraction of faulty items.
+var DefaultChecks = map[string]float64{
+	"articles-without-revisions": 0,
+	"topics-without-articles":    0.2,
+	"missing-abstracts":          0.5,
+	"empty-socialjumps":          0.9,
+	"revisions-before-wikipedia": 0,
+	"revisions-in-future":        0,
+	"timebounds":                 0,
+}
+
+//ParseChecks returns DefaultChecks modified by s, a comma separated list of name=threshold, where threshold "off" disables the check.
+func ParseChecks(s string) (checks map[string]float64, err error) {
+	checks = map[string]float64{}
+	for name, threshold := range DefaultChecks {
+		checks[name] = threshold
+	}
+
+	for _, check := range strings.Split(s, ",") {
+		if check = strings.TrimSpace(check); check == "" {
+			continue
+		}
+		nameThreshold := strings.SplitN(check, "=", 2)
+		name := nameThreshold[0]
+		if _, ok := DefaultChecks[name]; !ok || len(nameThreshold) != 2 {
+			return nil, errors.New("error: invalid data quality check " + check)
+		}
+		if nameThreshold[1] == "off" {
+			delete(checks, name)
+			continue
+		}
+		threshold, err := strconv.ParseFloat(nameThreshold[1], 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			return nil, errors.New("error: invalid threshold in data quality check " + check)
+		}
+		checks[name] = threshold
+	}
+	return
+}
+
+//CheckResult is the outcome of a data quality check.
+type CheckResult struct {
+	Name, Description string
+	Faulty, Total     int64
+	Fraction          float64
+	Threshold         float64
+	Passed            bool
+}
+
+//QualityReport contains the outcomes of the data quality checks.
+type QualityReport struct {
+	Checks []CheckResult
+	Passed bool
+}
+
+//Failed returns the checks that did not pass.
+func (r QualityReport) Failed() (failed []CheckResult) {
+	for _, c := range r.Checks {
+		if !c.Passed {
+			failed = append(failed, c)
+		}
+	}
+	return
+}
+
+//Validate evaluates the data quality checks computed upon import against the given thresholds.
+func (m Exporter) Validate(ctx context.Context, checks map[string]float64) (r QualityReport, err error) {
+	var results []CheckResult
+	err = m.db.SelectContext(ctx, &results, "SELECT name AS Name, description AS Description, faulty AS Faulty, total AS Total FROM w2o.qualitychecks;")
+	if err != nil {
+		return r, errors.Wrap(err, "Error while retrieving data quality checks")
+	}
+
+	r.Passed = true
+	for _, c := range results {
+		threshold, ok := checks[c.Name]
+		if !ok {
+			continue
+		}
+		c.Threshold = threshold
+		if c.Total > 0 {
+			c.Fraction = float64(c.Faulty) / float64(c.Total)
+		}
+		c.Passed = c.Fraction <= c.Threshold
+		r.Passed = r.Passed && c.Passed
+		r.Checks = append(r.Checks, c)
+	}
+	sort.Slice(r.Checks, func(i, j int) bool { return r.Checks[i].Name < r.Checks[j].Name })
+	return
+}
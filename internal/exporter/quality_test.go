@@ -0,0 +1,107 @@
+package exporter
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func TestParseChecks(t *testing.T) {
+	with := func(changes map[string]float64, off ...string) map[string]float64 {
+		checks := map[string]float64{}
+		for name, threshold := range DefaultChecks {
+			checks[name] = threshold
+		}
+		for name, threshold := range changes {
+			checks[name] = threshold
+		}
+		for _, name := range off {
+			delete(checks, name)
+		}
+		return checks
+	}
+	tests := []struct {
+		name    string
+		s       string
+		want    map[string]float64
+		wantErr bool
+	}{
+		{"empty", "", with(nil), false},
+		{"blanks and empty items", " , ,", with(nil), false},
+		{"threshold", "missing-abstracts=0.1", with(map[string]float64{"missing-abstracts": 0.1}), false},
+		{"off", "timebounds=off", with(nil, "timebounds"), false},
+		{"many", "missing-abstracts=1, timebounds=off,empty-socialjumps=0", with(map[string]float64{"missing-abstracts": 1, "empty-socialjumps": 0}, "timebounds"), false},
+		{"last wins", "missing-abstracts=0.1,missing-abstracts=0.3", with(map[string]float64{"missing-abstracts": 0.3}), false},
+		{"unknown check", "unknown=0.1", nil, true},
+		{"missing threshold", "missing-abstracts", nil, true},
+		{"invalid threshold", "missing-abstracts=half", nil, true},
+		{"negative threshold", "missing-abstracts=-0.1", nil, true},
+		{"threshold above one", "missing-abstracts=1.1", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChecks(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseChecksKeepsDefaults(t *testing.T) {
+	before := DefaultChecks["timebounds"]
+	if _, err := ParseChecks("timebounds=off,missing-abstracts=1"); err != nil {
+		t.Fatal(err)
+	}
+	if threshold, ok := DefaultChecks["timebounds"]; !ok || threshold != before || DefaultChecks["missing-abstracts"] != 0.5 {
+		t.Errorf("DefaultChecks have been modified: %v", DefaultChecks)
+	}
+}
+
+//TestImportArticlesWithoutRevisions needs the options of a PostgreSQL db in NEGAPEDIA_TEST_DB, whose server can read the test files.
+func TestImportArticlesWithoutRevisions(t *testing.T) {
+	dbopts := os.Getenv("NEGAPEDIA_TEST_DB")
+	if dbopts == "" {
+		t.Skip("NEGAPEDIA_TEST_DB is not set")
+	}
+	db, err := sqlx.Connect("postgres", dbopts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"pages":       "page_id,page_title,page_abstract,parent_id\n1,Topic,,0\n10,Edited,Abstract,1\n11,Never edited,Abstract,1\n",
+		"revisions":   "page_id,rev_serialid,user_id,user_isbot,rev_charweight,rev_chardiff,rev_isrevert,rev_isreverted,rev_timestamp\n10,0,7,false,1,100,0,false,2010-01-01 00:00:00\n10,1,,false,1,50,0,false,2011-01-01 00:00:00\n",
+		"socialjumps": "page_id,page_socialjumps\n10,{}\n",
+		"editwars":    "page_id,user_id,reverted_user_id,rev_timestamp\n",
+		"newcomers":   "page_id,user_id,rev_isreverted,rev_timestamp\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name+".csv"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	m, destructor, err := From(ctx, db, "en", dir, Window{}, Yearly, ExcludeBots, Thresholds{}, url.URL{}, url.URL{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer destructor()
+	r, err := m.Validate(ctx, map[string]float64{"articles-without-revisions": 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Checks) != 1 || r.Checks[0].Faulty != 1 || r.Checks[0].Total != 2 || r.Passed {
+		t.Errorf("got %+v, want 1 faulty article over 2", r)
+	}
+}
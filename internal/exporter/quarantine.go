@@ -0,0 +1,75 @@
+package exporter
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/jmoiron/sqlx/types"
+	"github.com/pkg/errors"
+)
+
+//ErrQuarantined is returned by VFile.WriteTo when the rendering failed within the error budget: the file must be skipped.
+var ErrQuarantined = errors.New("File quarantined")
+
+//Fault describes a page that has been skipped because of an error.
+type Fault struct {
+	ID           uint32
+	Title, Stage string
+	Error        string
+}
+
+//Quarantine collects the faults of the export, until its error budget is spent.
+type Quarantine struct {
+	mutex  sync.Mutex
+	Budget int
+	Faults []Fault
+}
+
+//NewQuarantine returns a Quarantine tolerating at most budget faults.
+func NewQuarantine(budget int) *Quarantine {
+	return &Quarantine{Budget: budget, Faults: []Fault{}}
+}
+
+//Add records the fault f, it returns a non nil error iff the error budget has been spent.
+//A nil Quarantine has no error budget.
+func (q *Quarantine) Add(f Fault) error {
+	if q == nil {
+		return errors.Errorf("Error in stage %v of page %v (ID %v): %v", f.Stage, f.Title, f.ID, f.Error)
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.Faults = append(q.Faults, f)
+	if len(q.Faults) > q.Budget {
+		return errors.Errorf("Error budget of %v spent, last error in stage %v of page %v (ID %v): %v", q.Budget, f.Stage, f.Title, f.ID, f.Error)
+	}
+	return nil
+}
+
+//MarshalJSON returns the quarantine report.
+func (q *Quarantine) MarshalJSON() ([]byte, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return json.Marshal(struct {
+		Budget int
+		Faults []Fault
+	}{q.Budget, q.Faults})
+}
+
+//quarantine records err as a fault of the page p, it returns a non nil error iff the export should stop.
+func (m Exporter) quarantine(p Page, stage string, err error) error {
+	return m.faults.Add(Fault{p.ID, p.Title, stage, err.Error()})
+}
+
+//jsonQuarantine is as quarantine, but it tries to recover the page from jsonText, which may be malformed.
+func (m Exporter) jsonQuarantine(jsonText types.JSONText, stage string, err error) error {
+	var i struct{ Page Page }
+	jsonText.Unmarshal(&i)
+	return m.quarantine(i.Page, stage, err)
+}
+
+//WithQuarantine returns a copy of m that skips failing pages, recording them in q.
+func (m Exporter) WithQuarantine(q *Quarantine) Exporter {
+	m.faults = q
+	return m
+}
@@ -0,0 +1,30 @@
+package exporter
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+//DataStats contains the statistics of the imported data, Users include bots only if they are included in the indices.
+type DataStats struct {
+	Articles, Topics, ArticlesWithSocialJumps   int64
+	Revisions, BotRevisions, AnonymousRevisions int64
+	Bots, Users                                 int64
+}
+
+//SocialJumpsCoverage returns the fraction of articles that have social jumps.
+func (s DataStats) SocialJumpsCoverage() float64 {
+	if s.Articles == 0 {
+		return 0
+	}
+	return float64(s.ArticlesWithSocialJumps) / float64(s.Articles)
+}
+
+//DataStats returns the statistics of the imported data.
+func (m Exporter) DataStats(ctx context.Context) (s DataStats, err error) {
+	err = m.db.GetContext(ctx, &s, `SELECT articles AS Articles, topics AS Topics, articleswithsocialjumps AS ArticlesWithSocialJumps,
+		revisions AS Revisions, botrevisions AS BotRevisions, anonymousrevisions AS AnonymousRevisions, bots AS Bots, users AS Users
+		FROM w2o.datastats;`)
+	return s, errors.Wrap(err, "Error while retrieving DataStats")
+}
@@ -1,19 +1,29 @@
 package exporter
 
 import (
-	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"sort"
 	"strings"
 
 	"github.com/jmoiron/sqlx/types"
+	"github.com/negapedia/negapedia/internal/watchdog"
 	"github.com/pkg/errors"
 )
 
 func (m Exporter) TopTens(ctx context.Context, fail func(error) error, out chan<- VFile) {
-	query, err := Asset("db/query-toptenbyyear.sql")
+	m.topTens(ctx, fail, out, "db/query-toptenbyyear.sql", m.jsonText2TopTens)
+}
+
+//CohortTopTens exports the top tens among the articles created in the same year.
+func (m Exporter) CohortTopTens(ctx context.Context, fail func(error) error, out chan<- VFile) {
+	m.topTens(ctx, fail, out, "db/query-cohorttoptenbyyear.sql", m.jsonText2CohortTopTens)
+}
+
+func (m Exporter) topTens(ctx context.Context, fail func(error) error, out chan<- VFile, queryAsset string, jsonText2TopTens func(types.JSONText) ([]TopTenInfo, error)) {
+	query, err := Asset(queryAsset)
 	if err != nil {
 		fail(errors.Wrap(err, "Error while Retrieving Query asset"))
 		return
@@ -25,6 +35,9 @@ func (m Exporter) TopTens(ctx context.Context, fail func(error) error, out chan<
 	}
 	defer rows.Close()
 
+	progress := watchdog.Progress(ctx, "toptens")
+	defer progress.Done()
+
 	var jsonText types.JSONText
 	for rows.Next() {
 		if err = rows.Scan(&jsonText); err != nil {
@@ -32,24 +45,41 @@ func (m Exporter) TopTens(ctx context.Context, fail func(error) error, out chan<
 			return
 		}
 
-		toptensInfo, err := m.jsonText2TopTens(jsonText)
+		toptensInfo, err := jsonText2TopTens(jsonText)
 		if err != nil {
-			fail(err)
-			return
+			if err = m.quarantine(Page{Title: "Top Tens"}, "unmarshal", errors.Wrap(err, "Error while Unmarshalling")); err != nil {
+				fail(err)
+				return
+			}
+			continue
 		}
 
 		for _, topten := range toptensInfo {
-			var b bytes.Buffer
-			if err = m.templates.ExecuteTemplate(&b, "topten.html", topten); err != nil {
-				fail(errors.Wrap(err, "Error while executing template"))
-				return
+			topten := topten
+			if topten.TopicID != 0 && Topic.UniversalFrom(topten.TopicID) == "" {
+				if err = m.quarantine(Page{ID: topten.TopicID, Title: topten.Title()}, "topic", errors.Errorf("Topic %v not found", topten.TopicID)); err != nil {
+					fail(err)
+					return
+				}
+				continue
+			}
+
+			render := func(w io.Writer) error {
+				err := m.templates.ExecuteTemplate(w, "topten.html", topten)
+				if err == nil {
+					return nil
+				}
+				if err = m.quarantine(Page{Title: topten.Title()}, "template", errors.Wrap(err, "Error while executing template")); err != nil {
+					return err
+				}
+				return ErrQuarantined
 			}
 
 			select {
 			case <-ctx.Done():
 				return
-			case out <- VFile{topten.FilePath(), string(b.Bytes())}:
-				//Go on
+			case out <- VFile{topten.FilePath(), TopTenFile, render}:
+				progress.Inc()
 			}
 		}
 	}
@@ -74,6 +104,25 @@ func (m *Exporter) jsonText2TopTens(jsonText types.JSONText) (ii []TopTenInfo, e
 	return
 }
 
+func (m *Exporter) jsonText2CohortTopTens(jsonText types.JSONText) (ii []TopTenInfo, err error) {
+	res := struct {
+		rawTopten
+		Cohort uint32
+	}{}
+	if err = jsonText.Unmarshal(&res); err != nil {
+		return
+	}
+
+	for _, index := range res.IndexesRanking {
+		for j, p := range index.Ranking {
+			index.Ranking[j].Abstract = smartTruncate(p.Abstract, 512)
+		}
+		ii = append(ii, TopTenInfo{m, SplittedAnnualIndexRanking{TTKey{res.Year, index.Index, 0, res.Cohort}, index.Ranking}})
+	}
+
+	return
+}
+
 type rawTopten struct {
 	Year           uint32
 	IndexesRanking []struct {
@@ -96,6 +145,7 @@ type TTKey struct {
 	Year    uint32 //0 iff it's all time
 	Index   string
 	TopicID uint32 //0 iff it's all
+	Cohort  uint32 //creation year of the ranked articles, 0 iff it's all
 }
 
 func (i TopTenInfo) FilePath() string {
@@ -108,6 +158,9 @@ func (i TopTenInfo) FilePath() string {
 		topic = "all"
 	}
 
+	if i.Cohort != 0 {
+		return path.Join("toptens", year, i.Index, "cohorts", fmt.Sprint(i.Cohort)+".html")
+	}
 	return path.Join("toptens", year, i.Index, topic+".html")
 }
 
@@ -132,6 +185,10 @@ func (i TopTenInfo) Title() string {
 		title += " for " + strings.Title(topic)
 	}
 
+	if i.Cohort != 0 {
+		title += fmt.Sprint(" among Articles Created in ", i.Cohort)
+	}
+
 	return title
 }
 
@@ -179,19 +236,19 @@ func splitRawTopten(rawTopten rawTopten) (rankings []SplittedAnnualIndexRanking)
 		index := indexRanking.Index
 		pp := indexRanking.Ranking
 		for _, p := range pp {
-			push(SplittedAnnualIndexRanking{TTKey{rawTopten.Year, index, p.ParentID}, []Page{p}})
+			push(SplittedAnnualIndexRanking{TTKey{rawTopten.Year, index, p.ParentID, 0}, []Page{p}})
 		}
 		if len(pp) > 10 {
 			pp = pp[:10]
 		}
-		push(SplittedAnnualIndexRanking{TTKey{rawTopten.Year, index, 0}, append([]Page{}, pp...)})
+		push(SplittedAnnualIndexRanking{TTKey{rawTopten.Year, index, 0, 0}, append([]Page{}, pp...)})
 	}
 
 	//Add all empty values to eventually generate empty topten pages
 	for year := range years {
 		for index := range indexes {
 			for topic := range topics {
-				push(SplittedAnnualIndexRanking{TTKey{year, index, topic}, []Page{}})
+				push(SplittedAnnualIndexRanking{TTKey{year, index, topic, 0}, []Page{}})
 			}
 		}
 	}
@@ -0,0 +1,47 @@
+package exporter
+
+import "testing"
+
+func TestTopTenInfoFilePath(t *testing.T) {
+	defer func(topic topicData) { Topic = topic }(Topic)
+	Topic = func(lang string, ID uint32) (topic, fullTopic string) {
+		if ID == 42 {
+			return "science", "Science and technology"
+		}
+		return "", ""
+	}
+
+	tests := []struct {
+		name string
+		key  TTKey
+		want string
+	}{
+		{"all time", TTKey{Index: "conflict"}, "toptens/all/conflict/all.html"},
+		{"year", TTKey{Year: 2010, Index: "conflict"}, "toptens/2010/conflict/all.html"},
+		{"topic", TTKey{Year: 2010, Index: "polemic", TopicID: 42}, "toptens/2010/polemic/science.html"},
+		{"cohort", TTKey{Year: 2010, Index: "conflict", Cohort: 2008}, "toptens/2010/conflict/cohorts/2008.html"},
+		{"cohort all time", TTKey{Index: "editwar", Cohort: 2008}, "toptens/all/editwar/cohorts/2008.html"},
+		{"cohort of the year", TTKey{Year: 2010, Index: "conflict", Cohort: 2010}, "toptens/2010/conflict/cohorts/2010.html"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := TopTenInfo{SplittedAnnualIndexRanking: SplittedAnnualIndexRanking{TTKey: tt.key}}
+			if got := i.FilePath(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopTenInfoFilePathsDiffer(t *testing.T) {
+	keys := []TTKey{{}, {Year: 2010}, {Cohort: 2010}, {Year: 2010, Cohort: 2010}, {Year: 2010, Cohort: 2009}}
+	seen := map[string]TTKey{}
+	for _, key := range keys {
+		key.Index = "conflict"
+		p := TopTenInfo{SplittedAnnualIndexRanking: SplittedAnnualIndexRanking{TTKey: key}}.FilePath()
+		if other, ok := seen[p]; ok {
+			t.Errorf("%v and %v share the path %v", key, other, p)
+		}
+		seen[p] = key
+	}
+}
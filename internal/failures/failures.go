@@ -0,0 +1,139 @@
+//Package failures collects every error of a run, along with the component that reported it and its stack.
+package failures
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//Failure is an error reported by a component.
+type Failure struct {
+	Component string
+	Time      time.Time
+	Err       error
+	Stack     string
+}
+
+//Collector records the failures of a context, which is canceled on the first one.
+//Failures are recorded also by the collectors of the parent contexts.
+type Collector struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	parent   *Collector
+	mutex    sync.Mutex
+	first    error
+	failures []Failure
+}
+
+type collectorKey struct{}
+
+//WithCollector returns a copy of ctx that is canceled on the first failure recorded by the returned Collector.
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	parent := From(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	c := &Collector{cancel: cancel, parent: parent}
+	c.ctx = context.WithValue(ctx, collectorKey{}, c)
+	return c.ctx, c
+}
+
+//From returns the Collector of ctx, or nil if there is none.
+func From(ctx context.Context) *Collector {
+	c, _ := ctx.Value(collectorKey{}).(*Collector)
+	return c
+}
+
+//Fail returns a function that records non nil errors as reported by component and returns the first error of the collector,
+//as a fallback the context error. It has the semantic of ctxutils.WithFail.
+//Once the context is canceled, cancellation errors are not recorded, as they come from components that are shutting down.
+func (c *Collector) Fail(component string) func(error) error {
+	return func(err error) error {
+		if err != nil && !(c.ctx.Err() != nil && errors.Cause(err) == context.Canceled) {
+			c.record(Failure{component, time.Now(), err, stack(err)})
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if c.first == nil && err != nil {
+			c.first = err
+			c.cancel()
+		}
+		if c.first == nil {
+			return c.ctx.Err()
+		}
+		return c.first
+	}
+}
+
+func (c *Collector) record(f Failure) {
+	for ; c != nil; c = c.parent {
+		c.mutex.Lock()
+		if !c.contains(f.Err) {
+			c.failures = append(c.failures, f)
+		}
+		c.mutex.Unlock()
+	}
+}
+
+//contains reports if err has already been recorded, e.g. when a component returns an error that has been reported by an inner one.
+func (c *Collector) contains(err error) bool {
+	if !reflect.TypeOf(err).Comparable() {
+		return false
+	}
+	for _, f := range c.failures {
+		if reflect.TypeOf(f.Err) == reflect.TypeOf(err) && f.Err == err {
+			return true
+		}
+	}
+	return false
+}
+
+//Failures returns every failure recorded up to now, in order of arrival.
+func (c *Collector) Failures() []Failure {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]Failure{}, c.failures...)
+}
+
+//String returns a summary of every failure recorded up to now.
+func (c *Collector) String() string {
+	ff := c.Failures()
+	if len(ff) == 0 {
+		return "No failures"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d failures, the first one caused the termination:\n", len(ff))
+	for i, f := range ff {
+		fmt.Fprintf(&b, "%d. [%s] at %s: %v\n", i+1, f.Component, f.Time.Format(time.RFC3339), f.Err)
+		fmt.Fprintf(&b, "\t%s\n", strings.Replace(strings.TrimSpace(f.Stack), "\n", "\n\t", -1))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+//stack returns the stack trace carried by err, if any, or the current one.
+func stack(err error) string {
+	type stackTracer interface {
+		StackTrace() errors.StackTrace
+	}
+	type causer interface {
+		Cause() error
+	}
+	for e := err; e != nil; {
+		if st, ok := e.(stackTracer); ok {
+			return fmt.Sprintf("%+v", st.StackTrace())
+		}
+		c, ok := e.(causer)
+		if !ok {
+			break
+		}
+		e = c.Cause()
+	}
+	return string(debug.Stack())
+}
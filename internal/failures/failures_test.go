@@ -0,0 +1,66 @@
+package failures
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestCollector(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	type report struct {
+		component string
+		err       error
+	}
+	tests := []struct {
+		name       string
+		reports    []report
+		wantFirst  error
+		wantFailed []string //components of the recorded failures
+	}{
+		{"no failures", []report{{"x", nil}}, nil, nil},
+		{"first failure", []report{{"x", errA}, {"y", errB}}, errA, []string{"x", "y"}},
+		{"nil after failure", []report{{"x", errA}, {"y", nil}}, errA, []string{"x"}},
+		{"same error", []report{{"inner", errA}, {"outer", errA}}, errA, []string{"inner"}},
+		{"cancellation after failure", []report{{"x", errA}, {"y", context.Canceled}, {"z", errors.Wrap(context.Canceled, "shutting down")}}, errA, []string{"x"}},
+		{"cancellation as first failure", []report{{"x", context.Canceled}}, context.Canceled, []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentCtx, parent := WithCollector(context.Background())
+			ctx, c := WithCollector(parentCtx)
+
+			var first error
+			for _, r := range tt.reports {
+				first = c.Fail(r.component)(r.err)
+			}
+			if first != tt.wantFirst {
+				t.Errorf("first error is %v, want %v", first, tt.wantFirst)
+			}
+			if (ctx.Err() != nil) != (tt.wantFirst != nil) {
+				t.Errorf("context error is %v with first error %v", ctx.Err(), tt.wantFirst)
+			}
+			if parentCtx.Err() != nil {
+				t.Error("parent context has been canceled")
+			}
+
+			for _, collector := range []*Collector{c, parent} {
+				var failed []string
+				for _, f := range collector.Failures() {
+					failed = append(failed, f.Component)
+				}
+				if !reflect.DeepEqual(failed, tt.wantFailed) {
+					t.Errorf("recorded failures of %v, want %v", failed, tt.wantFailed)
+				}
+			}
+		})
+	}
+}
+
+func TestFromWithoutCollector(t *testing.T) {
+	if c := From(context.Background()); c != nil {
+		t.Errorf("got collector %v from a context without one", c)
+	}
+}
@@ -14,11 +14,12 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ebonetti/similgraph"
+	"github.com/negapedia/negapedia/internal/watchdog"
 )
 
 type multiEdge struct {
 	VertexA   uint32
-	VerticesB map[uint32]float64
+	VerticesB *userWeights
 }
 
 type vertexLinks struct {
@@ -30,11 +31,12 @@ var _BufferSize = 10 * runtime.NumCPU()
 
 func (p preprocessor) bi2Similgraph(ctx context.Context, in <-chan multiEdge) <-chan vertexLinks {
 	vertexLinksChan := make(chan vertexLinks, _BufferSize)
+	fail := p.Failures.Fail("bi2Similgraph")
 	go func() {
 		defer close(vertexLinksChan)
 		g, new2OldID, err := p.newSimilgraph(ctx, in)
 		if err != nil {
-			p.Fail(err)
+			fail(err)
 			return
 		}
 
@@ -46,6 +48,9 @@ func (p preprocessor) bi2Similgraph(ctx context.Context, in <-chan multiEdge) <-
 			}
 		}()
 
+		progress := watchdog.Progress(ctx, "bi2Similgraph")
+		defer progress.Done()
+
 		wg := sync.WaitGroup{}
 		for workers := 0; workers < cap(pageIDsChan); workers++ {
 			wg.Add(1)
@@ -56,7 +61,7 @@ func (p preprocessor) bi2Similgraph(ctx context.Context, in <-chan multiEdge) <-
 				for v := range pageIDsChan {
 					itsm, itbg, err := g.EdgeIterator(v)
 					if err != nil {
-						p.Fail(err)
+						fail(err)
 						return
 					}
 					n := topN(buffer, concat(itsm, itbg))
@@ -66,7 +71,7 @@ func (p preprocessor) bi2Similgraph(ctx context.Context, in <-chan multiEdge) <-
 					}
 					select {
 					case vertexLinksChan <- vertexLinks{From: new2OldID[v], To: links}:
-						//proceed
+						progress.Inc()
 					case <-ctx.Done():
 						return
 					}
@@ -84,15 +89,18 @@ func (p preprocessor) newSimilgraph(ctx context.Context, in <-chan multiEdge) (g
 	sortedBigraphChan := p.sortEdges(ctx, bigraphChan)
 
 	for me := range in {
-		for UserID, Weight := range me.VerticesB {
+		err = me.VerticesB.Range(ctx, func(UserID uint32, Weight float64) error {
 			select {
 			case bigraphChan <- similgraph.Edge{me.VertexA, UserID, float32(Weight)}:
 				//proceed
 			case <-ctx.Done():
-				err = ctx.Err() //no need to close bigraphChan, it will sense ctx.Done itself
-				return
+				return ctx.Err() //no need to close bigraphChan, it will sense ctx.Done itself
 			}
 			users2PageCount[UserID]++
+			return nil
+		})
+		if err != nil {
+			return
 		}
 		pageCount++
 	}
@@ -112,8 +120,12 @@ func (p preprocessor) newSimilgraph(ctx context.Context, in <-chan multiEdge) (g
 
 func (p preprocessor) sortEdges(ctx context.Context, edges <-chan similgraph.Edge) <-chan similgraph.Edge {
 	result := make(chan similgraph.Edge, _BufferSize)
+	fail := p.Failures.Fail("sortEdges")
 	go func() {
 		defer close(result)
+		progress := watchdog.Progress(ctx, "sortEdges")
+		defer progress.Done()
+
 		cmd := exec.CommandContext(ctx, "sort", "-n", "-k", "1,1", "-k", "2,2", "-S", "10%", "-T", p.TmpDir)
 		var cmdStderr bytes.Buffer
 		cmd.Stderr = &cmdStderr
@@ -125,7 +137,7 @@ func (p preprocessor) sortEdges(ctx context.Context, edges <-chan similgraph.Edg
 			err = errin
 			fallthrough
 		case err != nil:
-			p.Fail(errors.Wrap(err, "Error opening sort pipe"))
+			fail(errors.Wrap(err, "Error opening sort pipe"))
 			return
 		}
 
@@ -134,14 +146,15 @@ func (p preprocessor) sortEdges(ctx context.Context, edges <-chan similgraph.Edg
 			for e := range edges {
 				_, err := fmt.Fprintln(stdin, e.VertexA, e.VertexB, e.Weight)
 				if err != nil {
-					p.Fail(errors.Wrap(err, "Error while inputting bigraph to sort"))
+					fail(errors.Wrap(err, "Error while inputting bigraph to sort"))
 					return
 				}
+				progress.Inc()
 			}
 		}()
 
 		if err := cmd.Start(); err != nil {
-			p.Fail(errors.Wrap(err, "Error while starting sort"))
+			fail(errors.Wrap(err, "Error while starting sort"))
 			return
 		}
 
@@ -153,22 +166,23 @@ func (p preprocessor) sortEdges(ctx context.Context, edges <-chan similgraph.Edg
 			case io.EOF:
 				err = nil
 			default:
-				p.Fail(errors.Wrap(err, "Error while fetching next edge after sort, with the following error stream:\n"+cmdStderr.String()))
+				fail(errors.Wrap(err, "Error while fetching next edge after sort, with the following error stream:\n"+cmdStderr.String()))
 			}
 			return
 		}
 
+	edgesLoop:
 		for e, ok := next(); ok; e, ok = next() {
 			select {
 			case result <- e:
-				//proceed
+				progress.Inc()
 			case <-ctx.Done():
-				break
+				break edgesLoop
 			}
 		}
 
 		if err1 := cmd.Wait(); err == nil && err1 != nil {
-			p.Fail(errors.Wrap(err1, "Error while waiting for sort end, with the following error stream:\n"+cmdStderr.String()))
+			fail(errors.Wrap(err1, "Error while waiting for sort end, with the following error stream:\n"+cmdStderr.String()))
 		}
 	}()
 	return result
@@ -0,0 +1,282 @@
+package preprocessor
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/gob"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/negapedia/wikibrief"
+	"github.com/pkg/errors"
+)
+
+//The replay cache is a gzipped gob stream: a header followed, for each article, by the article and its revisions.
+//Revision texts are stored as differences from one of the last cacheWindow texts of the same article, or just as their length if Texts is false.
+
+const cacheVersion = 2
+const cacheWindow = 16
+
+type cacheHeader struct {
+	Version int
+	Lang    string
+	Test    bool
+	Texts   bool
+}
+
+type cacheRecord struct {
+	Page     *cachePage
+	Revision *cacheRevision
+}
+
+type cachePage struct {
+	PageID          uint32
+	Title, Abstract string
+	TopicID         uint32
+}
+
+type cacheRevision struct {
+	ID, UserID uint32
+	IsBot      bool
+	SHA1       string
+	IsRevert   uint32
+	Timestamp  time.Time
+	//Text is Middle between the first Prefix and the last Suffix bytes of the Base-th last text, or just Middle if Base is 0
+	Base, Prefix, Suffix int
+	Middle               string
+	//Length is the length of Text, used in place of it if the cache has no texts
+	Length int
+}
+
+//Recorded returns a Source that forwards the articles of source while recording them in the replay cache at path,
+//revision texts are recorded only if texts is true, as processes that need only their length do not need them.
+//The cache is replaced only if the whole stream has been recorded.
+func Recorded(source Source, path string, texts bool) Source {
+	return func(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage {
+		in := source(ctx, fail, tmpDir, lang, test)
+		out := make(chan wikibrief.EvolvingPage)
+		go func() {
+			defer close(out)
+			defer func() {
+				for range in {
+					//drain
+				}
+			}()
+
+			f, err := os.Create(path + ".tmp")
+			if err != nil {
+				fail(errors.Wrap(err, "Error while creating replay cache"))
+				return
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+			b := bufio.NewWriter(f)
+			g, _ := gzip.NewWriterLevel(b, gzip.BestSpeed)
+			encoder := gob.NewEncoder(g)
+			if err = encoder.Encode(cacheHeader{cacheVersion, lang, test, texts}); err != nil {
+				fail(errors.Wrap(err, "Error while writing replay cache"))
+				return
+			}
+
+			for p := range in {
+				revisions := make(chan wikibrief.Revision, 100)
+				select {
+				case out <- wikibrief.EvolvingPage{PageID: p.PageID, Title: p.Title, Abstract: p.Abstract, TopicID: p.TopicID, Revisions: revisions}:
+					//proceed
+				case <-ctx.Done():
+					close(revisions)
+					return
+				}
+
+				err = encoder.Encode(cacheRecord{Page: &cachePage{p.PageID, p.Title, p.Abstract, p.TopicID}})
+				var window textWindow
+				for r := range p.Revisions {
+					if err == nil {
+						err = encoder.Encode(cacheRecord{Revision: window.encode(r, texts)})
+					}
+					select {
+					case revisions <- r:
+						//proceed
+					case <-ctx.Done():
+						close(revisions)
+						return
+					}
+				}
+				close(revisions)
+				if err != nil {
+					fail(errors.Wrap(err, "Error while writing replay cache"))
+					return
+				}
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+			for _, closer := range []func() error{g.Close, b.Flush, f.Close} {
+				if err = closer(); err != nil {
+					fail(errors.Wrap(err, "Error while writing replay cache"))
+					return
+				}
+			}
+			if err = os.Rename(f.Name(), path); err != nil {
+				fail(errors.Wrap(err, "Error while writing replay cache"))
+			}
+		}()
+		return out
+	}
+}
+
+//Replay returns a Source that replays the articles recorded in the replay cache at path, it fails if texts is true and the cache has no texts.
+//Revision texts of a cache without texts are replayed as blanks of the recorded length.
+func Replay(path string, texts bool) Source {
+	return func(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage {
+		out := make(chan wikibrief.EvolvingPage)
+		go func() {
+			defer close(out)
+			f, err := os.Open(path)
+			if err != nil {
+				fail(errors.Wrap(err, "Error while opening replay cache"))
+				return
+			}
+			defer f.Close()
+			g, err := gzip.NewReader(bufio.NewReader(f))
+			if err != nil {
+				fail(errors.Wrap(err, "Error while reading replay cache"))
+				return
+			}
+			decoder := gob.NewDecoder(g)
+
+			var header cacheHeader
+			switch err = decoder.Decode(&header); {
+			case err != nil:
+				fail(errors.Wrap(err, "Error while reading replay cache"))
+				return
+			case header.Version != cacheVersion || header.Lang != lang || header.Test != test:
+				fail(errors.Errorf("Replay cache %v has been recorded with version %v, lang %v and test %v", path, header.Version, header.Lang, header.Test))
+				return
+			case texts && !header.Texts:
+				fail(errors.Errorf("Replay cache %v has been recorded without revision texts, needed by the selected processes", path))
+				return
+			}
+
+			var revisions chan wikibrief.Revision
+			defer func() {
+				if revisions != nil {
+					close(revisions)
+				}
+			}()
+			var window textWindow
+			var blanks string
+			for {
+				var record cacheRecord
+				switch err = decoder.Decode(&record); {
+				case err == io.EOF:
+					return
+				case err != nil:
+					fail(errors.Wrap(err, "Error while reading replay cache"))
+					return
+				case record.Page != nil:
+					if revisions != nil {
+						close(revisions)
+					}
+					revisions, window = make(chan wikibrief.Revision, 100), textWindow{}
+					p := record.Page
+					select {
+					case out <- wikibrief.EvolvingPage{PageID: p.PageID, Title: p.Title, Abstract: p.Abstract, TopicID: p.TopicID, Revisions: revisions}:
+						//proceed
+					case <-ctx.Done():
+						return
+					}
+				case record.Revision != nil && revisions != nil:
+					r, err := window.decode(record.Revision, header.Texts)
+					if err != nil {
+						fail(errors.Wrap(err, "Error while reading replay cache"))
+						return
+					}
+					if !header.Texts {
+						if len(blanks) < record.Revision.Length {
+							blanks = strings.Repeat(" ", 2*record.Revision.Length)
+						}
+						r.Text = blanks[:record.Revision.Length]
+					}
+					select {
+					case revisions <- r:
+						//proceed
+					case <-ctx.Done():
+						return
+					}
+				default:
+					fail(errors.New("Error while reading replay cache: malformed record"))
+					return
+				}
+			}
+		}()
+		return out
+	}
+}
+
+//textWindow contains the last texts of an article.
+type textWindow struct {
+	texts, SHA1s []string
+}
+
+func (w *textWindow) push(text, SHA1 string) {
+	if len(w.texts) == cacheWindow {
+		w.texts, w.SHA1s = w.texts[1:], w.SHA1s[1:]
+	}
+	w.texts, w.SHA1s = append(w.texts, text), append(w.SHA1s, SHA1)
+}
+
+func (w *textWindow) encode(r wikibrief.Revision, texts bool) (c *cacheRevision) {
+	c = &cacheRevision{ID: r.ID, UserID: r.UserID, IsBot: r.IsBot, SHA1: r.SHA1, IsRevert: r.IsRevert, Timestamp: r.Timestamp, Middle: r.Text, Length: len(r.Text)}
+	if !texts {
+		c.Middle = ""
+		return
+	}
+	defer w.push(r.Text, r.SHA1)
+
+	n := len(w.texts)
+	for i := n - 1; i >= 0; i-- { //reverts match a previous text
+		if r.SHA1 != "" && w.SHA1s[i] == r.SHA1 && w.texts[i] == r.Text {
+			c.Base, c.Prefix, c.Middle = n-i, len(r.Text), ""
+			return
+		}
+	}
+	if n == 0 {
+		return
+	}
+
+	base := w.texts[n-1]
+	prefix := 0
+	for prefix < len(base) && prefix < len(r.Text) && base[prefix] == r.Text[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(base)-prefix && suffix < len(r.Text)-prefix && base[len(base)-1-suffix] == r.Text[len(r.Text)-1-suffix] {
+		suffix++
+	}
+	c.Base, c.Prefix, c.Suffix, c.Middle = 1, prefix, suffix, r.Text[prefix:len(r.Text)-suffix]
+	return
+}
+
+func (w *textWindow) decode(c *cacheRevision, texts bool) (r wikibrief.Revision, err error) {
+	r = wikibrief.Revision{ID: c.ID, UserID: c.UserID, IsBot: c.IsBot, SHA1: c.SHA1, IsRevert: c.IsRevert, Timestamp: c.Timestamp, Text: c.Middle}
+	if !texts {
+		return
+	}
+	if c.Base > 0 {
+		if c.Base > len(w.texts) {
+			return r, errors.Errorf("revision %v refers to a missing text", c.ID)
+		}
+		base := w.texts[len(w.texts)-c.Base]
+		if c.Prefix+c.Suffix > len(base) {
+			return r, errors.Errorf("revision %v refers to a shorter text", c.ID)
+		}
+		r.Text = base[:c.Prefix] + c.Middle + base[len(base)-c.Suffix:]
+	}
+	w.push(r.Text, r.SHA1)
+	return
+}
@@ -0,0 +1,73 @@
+package preprocessor
+
+import (
+	"time"
+
+	"github.com/negapedia/wikibrief"
+)
+
+//_EditWarHistory is the maximum number of previous revisions that a revert is considered to revert.
+const _EditWarHistory = 100
+
+//editWarDetector detects, in the revisions of an article, mutual reverts between user pairs within window.
+type editWarDetector struct {
+	window      time.Duration
+	includeBots bool
+	authors     []uint32                //authors of the last revisions, AnonimousUserID for anonymous users and bots
+	lastReverts map[[2]uint32]time.Time //(reverting, reverted) user pair to the time of the last revert
+	reverts     []revertEvent           //reverts in lastReverts, ordered by time
+}
+
+type revertEvent struct {
+	Users     [2]uint32
+	Timestamp time.Time
+}
+
+func newEditWarDetector(window time.Duration, includeBots bool) *editWarDetector {
+	return &editWarDetector{window: window, includeBots: includeBots, lastReverts: map[[2]uint32]time.Time{}}
+}
+
+//Add processes the next revision, returning the users reverted by it that reverted its author within window.
+func (d *editWarDetector) Add(r Revision) (opponents []uint32) {
+	for len(d.reverts) > 0 && r.Timestamp.Sub(d.reverts[0].Timestamp) > d.window { //forget old reverts
+		e := d.reverts[0]
+		if d.lastReverts[e.Users].Equal(e.Timestamp) {
+			delete(d.lastReverts, e.Users)
+		}
+		d.reverts = d.reverts[1:]
+	}
+
+	author := r.UserID
+	if r.IsBot && !d.includeBots {
+		author = wikibrief.AnonimousUserID
+	}
+	defer func() {
+		d.authors = append(d.authors, author)
+		if len(d.authors) > _EditWarHistory {
+			d.authors = d.authors[1:]
+		}
+	}()
+	if author == wikibrief.AnonimousUserID || r.IsRevert == 0 {
+		return
+	}
+
+	n := int(r.IsRevert)
+	if n > len(d.authors) {
+		n = len(d.authors)
+	}
+	reverted := map[uint32]bool{}
+	for _, u := range d.authors[len(d.authors)-n:] {
+		if u == wikibrief.AnonimousUserID || u == author || reverted[u] {
+			continue
+		}
+		reverted[u] = true
+
+		if t, ok := d.lastReverts[[2]uint32{u, author}]; ok && r.Timestamp.Sub(t) <= d.window {
+			opponents = append(opponents, u)
+		}
+		users := [2]uint32{author, u}
+		d.lastReverts[users] = r.Timestamp
+		d.reverts = append(d.reverts, revertEvent{users, r.Timestamp})
+	}
+	return
+}
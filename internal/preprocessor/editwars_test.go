@@ -0,0 +1,48 @@
+package preprocessor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/negapedia/wikibrief"
+)
+
+func TestEditWarDetector(t *testing.T) {
+	t0 := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	const window = 48 * time.Hour
+	rev := func(userID uint32, isBot bool, isRevert uint32, after time.Duration) Revision {
+		return Revision{UserID: userID, IsBot: isBot, IsRevert: isRevert, Timestamp: t0.Add(after)}
+	}
+	tests := []struct {
+		name        string
+		includeBots bool
+		revisions   []Revision
+		want        [][]uint32 //opponents by revision
+	}{
+		{"no reverts", false, []Revision{rev(1, false, 0, 0), rev(2, false, 0, time.Hour)}, [][]uint32{nil, nil}},
+		{"mutual reverts", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, time.Hour), rev(1, false, 1, 2*time.Hour)}, [][]uint32{nil, nil, {2}}},
+		{"equal timestamps", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, 0), rev(1, false, 1, 0)}, [][]uint32{nil, nil, {2}}},
+		{"at window end", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, 0), rev(1, false, 1, window)}, [][]uint32{nil, nil, {2}}},
+		{"past window end", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, 0), rev(1, false, 1, window+time.Nanosecond)}, [][]uint32{nil, nil, nil}},
+		{"one-sided reverts", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, time.Hour), rev(1, false, 0, 2*time.Hour), rev(2, false, 1, 3*time.Hour)}, [][]uint32{nil, nil, nil, nil}},
+		{"war goes on", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, time.Hour), rev(1, false, 1, 2*time.Hour), rev(2, false, 1, 3*time.Hour)}, [][]uint32{nil, nil, {2}, {1}}},
+		{"self revert", false, []Revision{rev(1, false, 0, 0), rev(1, false, 1, time.Hour), rev(1, false, 1, 2*time.Hour)}, [][]uint32{nil, nil, nil}},
+		{"anonymous", false, []Revision{rev(1, false, 0, 0), rev(wikibrief.AnonimousUserID, false, 1, time.Hour), rev(1, false, 1, 2*time.Hour)}, [][]uint32{nil, nil, nil}},
+		{"bots excluded", false, []Revision{rev(1, false, 0, 0), rev(2, true, 1, time.Hour), rev(1, false, 1, 2*time.Hour)}, [][]uint32{nil, nil, nil}},
+		{"bots included", true, []Revision{rev(1, false, 0, 0), rev(2, true, 1, time.Hour), rev(1, false, 1, 2*time.Hour)}, [][]uint32{nil, nil, {2}}},
+		{"revert depth", false, []Revision{rev(1, false, 0, 0), rev(2, false, 1, 0), rev(3, false, 0, 0), rev(1, false, 2, 0)}, [][]uint32{nil, nil, nil, {2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newEditWarDetector(window, tt.includeBots)
+			var got [][]uint32
+			for _, r := range tt.revisions {
+				got = append(got, d.Add(r))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,20 +5,26 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/gocarina/gocsv"
+	"github.com/negapedia/negapedia/internal/watchdog"
 	"github.com/negapedia/wikibrief"
 	"github.com/pkg/errors"
 )
 
-func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, articles <-chan wikibrief.EvolvingPage) {
+func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, articles <-chan Article) {
 	csvArticleRevisionChan := make(chan interface{}, 10000)
 
+	//mutual reverts of edit wars
+	csvEditWarChan := make(chan interface{}, 10000)
+
+	//first edits of users to articles
+	csvNewcomerChan := make(chan interface{}, 10000)
+
 	//pages: topics and articles
 	csvPageChan := make(chan interface{}, 10000)
 
@@ -30,9 +36,14 @@ func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, art
 
 	go func() {
 		defer close(csvArticleRevisionChan)
+		defer close(csvEditWarChan)
+		defer close(csvNewcomerChan)
 		defer close(csvPageChan)
 		defer close(articleMultiEdgeChan)
 
+		progress := watchdog.Progress(ctx, "exportCSV")
+		defer progress.Done()
+
 		for _, t := range p.Topics { //dump topics
 			select {
 			case csvPageChan <- &csvPage{ID: t.ID, Title: t.Title}:
@@ -42,12 +53,25 @@ func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, art
 			}
 		}
 
+		send := func(ch chan<- interface{}, v interface{}) bool { //false iff the export should stop
+			select {
+			case ch <- v:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
 		for a := range articles {
-			users2weight := make(map[uint32]float64, len(a.Revisions))
+			users2weight := newUserWeights(p.TmpDir)
+			editWars := newEditWarDetector(p.Settings.EditWarWindow, p.Settings.IncludeBots)
+			newcomers := newNewcomerDetector(p.Settings.NewcomerRevertWindow, p.Settings.IncludeBots)
+			var reverts revertMarker
 			serialRevisionID := uint32(0)
 			oldWeight := float64(0)
 			for r := range a.Revisions {
 				serialRevisionID++
+				progress.Inc()
 
 				//User data
 				var userID *uint32
@@ -56,31 +80,49 @@ func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, art
 				}
 
 				//Revision metric data
-				weight := float64(len(r.Text))
+				weight := float64(r.Length)
 				diff := weight - oldWeight
 				oldWeight = weight
 
 				//Export to csv
-				csvArticleRevisionChan <- &csvRevision{a.PageID, serialRevisionID, userID, r.IsBot, weight, diff, r.IsRevert, false, r.Timestamp.Format(time.RFC3339Nano)}
+				if done := reverts.Add(&csvRevision{a.PageID, serialRevisionID, userID, r.IsBot, weight, diff, r.IsRevert, false, r.Timestamp.Format(time.RFC3339Nano)}); done != nil && !send(csvArticleRevisionChan, done) {
+					return
+				}
+				for _, opponent := range editWars.Add(r) {
+					if !send(csvEditWarChan, &csvEditWar{a.PageID, r.UserID, opponent, r.Timestamp.Format(time.RFC3339Nano)}) {
+						return
+					}
+				}
+				for _, e := range newcomers.Add(r) {
+					if !send(csvNewcomerChan, &csvNewcomer{a.PageID, e.UserID, e.Reverted, e.Timestamp.Format(time.RFC3339Nano)}) {
+						return
+					}
+				}
 
 				//Convert data for socialjumps
-				if r.IsBot || r.UserID == wikibrief.AnonimousUserID {
+				if (r.IsBot && !p.Settings.IncludeBots) || r.UserID == wikibrief.AnonimousUserID {
 					continue //do not use for social jumps calculations
 				}
 
-				userWeight := users2weight[r.UserID]
-				switch {
-				case r.IsRevert > 0:
-					users2weight[r.UserID] = math.Max(userWeight, 1.0)
-				case diff <= 100.0: //&& isPositive
-					users2weight[r.UserID] = math.Max(userWeight, 10.0)
-				case userWeight <= 10:
-					userWeight = 0 //Resetting weight for different scheme.
-					fallthrough
-				default:
-					users2weight[r.UserID] = math.Min(userWeight+diff/10, 100)
+				if err := users2weight.Add(r.UserID, r.IsRevert > 0, diff); err != nil {
+					fail(err)
+					return
 				}
 			}
+			for _, r := range reverts.Flush() {
+				if !send(csvArticleRevisionChan, r) {
+					return
+				}
+			}
+			for _, e := range newcomers.Flush() {
+				if !send(csvNewcomerChan, &csvNewcomer{a.PageID, e.UserID, e.Reverted, e.Timestamp.Format(time.RFC3339Nano)}) {
+					return
+				}
+			}
+			if err := users2weight.Close(); err != nil {
+				fail(err)
+				return
+			}
 
 			csvPageChan := csvPageChan
 			articleMultiEdgeChan := articleMultiEdgeChan
@@ -97,16 +139,33 @@ func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, art
 		}
 	}()
 
+	csvFail := p.Failures.Fail("chan2csv")
 	doneArticleRevisionWriting := make(chan interface{})
 	go func() {
 		defer close(doneArticleRevisionWriting)
 		if err := chan2csv(csvArticleRevisionChan, filepath.Join(p.CSVDir, "revisions.csv")); err != nil {
-			p.Fail(err)
+			csvFail(err)
+		}
+	}()
+
+	doneEditWarWriting := make(chan interface{})
+	go func() {
+		defer close(doneEditWarWriting)
+		if err := chan2csv(csvEditWarChan, filepath.Join(p.CSVDir, "editwars.csv")); err != nil {
+			csvFail(err)
+		}
+	}()
+
+	doneNewcomerWriting := make(chan interface{})
+	go func() {
+		defer close(doneNewcomerWriting)
+		if err := chan2csv(csvNewcomerChan, filepath.Join(p.CSVDir, "newcomers.csv")); err != nil {
+			csvFail(err)
 		}
 	}()
 
 	if err := chan2csv(csvPageChan, filepath.Join(p.CSVDir, "pages.csv")); err != nil {
-		fail(err)
+		csvFail(err)
 		return
 	}
 
@@ -125,11 +184,13 @@ func (p preprocessor) exportCSV(ctx context.Context, fail func(error) error, art
 	}()
 
 	if err := chan2csv(csvSocialJumpsChan, filepath.Join(p.CSVDir, "socialjumps.csv")); err != nil {
-		fail(err)
+		csvFail(err)
 		return
 	}
 
 	<-doneArticleRevisionWriting
+	<-doneEditWarWriting
+	<-doneNewcomerWriting
 
 	return
 }
@@ -170,6 +231,20 @@ type csvRevision struct {
 	Timestamp  string  `csv:"timestamp"`
 }
 
+type csvEditWar struct {
+	PageID         uint32 `csv:"pageid"`
+	UserID         uint32 `csv:"userid"`
+	RevertedUserID uint32 `csv:"reverteduserid"`
+	Timestamp      string `csv:"timestamp"`
+}
+
+type csvNewcomer struct {
+	PageID    uint32 `csv:"pageid"`
+	UserID    uint32 `csv:"userid"`
+	Reverted  bool   `csv:"reverted"`
+	Timestamp string `csv:"timestamp"`
+}
+
 type csvPage struct {
 	ID       uint32 `csv:"id"`
 	Title    string `csv:"title"`
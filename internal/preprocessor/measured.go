@@ -0,0 +1,113 @@
+package preprocessor
+
+import (
+	"context"
+	"time"
+
+	"github.com/negapedia/wikibrief"
+)
+
+//MeasuredProcess is a Process that needs only the length of the revision texts.
+type MeasuredProcess func(ctx context.Context, fail func(error) error, articles <-chan Article)
+
+//Article is a wikibrief.EvolvingPage whose revision texts have been replaced by their length.
+type Article struct {
+	PageID          uint32
+	Title, Abstract string
+	TopicID         uint32
+	Revisions       <-chan Revision
+}
+
+//Revision is a wikibrief.Revision whose text has been replaced by its length.
+type Revision struct {
+	ID, UserID uint32
+	IsBot      bool
+	Length     int
+	IsRevert   uint32
+	Timestamp  time.Time
+}
+
+//measure drops revision texts as soon as they are read, so that texts are not kept around by measured processes.
+func measure(ctx context.Context, pages <-chan wikibrief.EvolvingPage) <-chan Article {
+	out := make(chan Article, _BufferSize)
+	go func() {
+		defer close(out)
+		for p := range pages {
+			revisions := make(chan Revision, _BufferSize)
+			select {
+			case out <- Article{p.PageID, p.Title, p.Abstract, p.TopicID, revisions}:
+				//proceed
+			case <-ctx.Done():
+				close(revisions)
+				return
+			}
+			for r := range p.Revisions {
+				select {
+				case revisions <- Revision{r.ID, r.UserID, r.IsBot, len(r.Text), r.IsRevert, r.Timestamp}:
+					//proceed
+				case <-ctx.Done():
+					close(revisions)
+					return
+				}
+			}
+			close(revisions)
+		}
+	}()
+	return out
+}
+
+//fanOutArticles duplicates in, every consumer must read the revisions of each article before reading the next article.
+func fanOutArticles(ctx context.Context, in <-chan Article, n int) []<-chan Article {
+	if n == 1 {
+		return []<-chan Article{in}
+	}
+
+	outs := make([]chan Article, n)
+	result := make([]<-chan Article, n)
+	for i := range outs {
+		outs[i] = make(chan Article, _BufferSize)
+		result[i] = outs[i]
+	}
+	go func() {
+		var revisions []chan Revision //revisions of the current article
+		defer func() {
+			for _, rr := range revisions {
+				close(rr)
+			}
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for a := range in {
+			revisions = make([]chan Revision, n)
+			for i := range revisions {
+				revisions[i] = make(chan Revision, _BufferSize)
+			}
+			for i, out := range outs {
+				b := a
+				b.Revisions = revisions[i]
+				select {
+				case out <- b:
+					//proceed
+				case <-ctx.Done():
+					return
+				}
+			}
+			for r := range a.Revisions {
+				for _, rr := range revisions {
+					select {
+					case rr <- r:
+						//proceed
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			for _, rr := range revisions {
+				close(rr)
+			}
+			revisions = nil
+		}
+	}()
+	return result
+}
@@ -0,0 +1,59 @@
+package preprocessor
+
+import (
+	"time"
+
+	"github.com/negapedia/wikibrief"
+)
+
+//newcomerEdit is the first edit of a registered user to an article.
+type newcomerEdit struct {
+	Serial    uint32
+	UserID    uint32
+	Timestamp time.Time
+	Reverted  bool
+}
+
+//newcomerDetector detects, in the revisions of an article, the first edits of users that get reverted within window revisions.
+type newcomerDetector struct {
+	window      int
+	includeBots bool
+	seen        map[uint32]bool //users that already edited the article
+	pending     []newcomerEdit  //first edits still within window, ordered by serial
+	serial      uint32
+}
+
+func newNewcomerDetector(window int, includeBots bool) *newcomerDetector {
+	return &newcomerDetector{window: window, includeBots: includeBots, seen: map[uint32]bool{}}
+}
+
+//Add processes the next revision, returning the first edits whose window ended.
+func (d *newcomerDetector) Add(r Revision) (done []newcomerEdit) {
+	d.serial++
+	if r.IsRevert > 0 { //revision reverts the previous IsRevert ones
+		for i := range d.pending {
+			if e := &d.pending[i]; d.serial-e.Serial <= r.IsRevert && e.UserID != r.UserID {
+				e.Reverted = true
+			}
+		}
+	}
+
+	n := 0
+	for n < len(d.pending) && int(d.serial-d.pending[n].Serial) >= d.window {
+		n++
+	}
+	done, d.pending = d.pending[:n:n], d.pending[n:]
+
+	if (r.IsBot && !d.includeBots) || r.UserID == wikibrief.AnonimousUserID || d.seen[r.UserID] {
+		return
+	}
+	d.seen[r.UserID] = true
+	d.pending = append(d.pending, newcomerEdit{d.serial, r.UserID, r.Timestamp, false})
+	return
+}
+
+//Flush returns the first edits still within their window, at the end of the article.
+func (d *newcomerDetector) Flush() (done []newcomerEdit) {
+	done, d.pending = d.pending, nil
+	return
+}
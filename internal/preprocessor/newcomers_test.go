@@ -0,0 +1,56 @@
+package preprocessor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/negapedia/wikibrief"
+)
+
+func TestNewcomerDetector(t *testing.T) {
+	t0 := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	rev := func(userID uint32, isBot bool, isRevert uint32) Revision {
+		return Revision{UserID: userID, IsBot: isBot, IsRevert: isRevert, Timestamp: t0}
+	}
+	type edit struct {
+		UserID   uint32
+		Reverted bool
+	}
+	tests := []struct {
+		name        string
+		window      int
+		includeBots bool
+		revisions   []Revision
+		want        []edit
+	}{
+		{"no revisions", 10, false, nil, nil},
+		{"not reverted", 10, false, []Revision{rev(1, false, 0), rev(2, false, 0)}, []edit{{1, false}, {2, false}}},
+		{"reverted by next", 10, false, []Revision{rev(1, false, 0), rev(2, false, 1)}, []edit{{1, true}, {2, false}}},
+		{"only first edits", 10, false, []Revision{rev(1, false, 0), rev(2, false, 0), rev(1, false, 0), rev(2, false, 1)}, []edit{{1, false}, {2, false}}},
+		{"self revert", 10, false, []Revision{rev(1, false, 0), rev(1, false, 1)}, []edit{{1, false}}},
+		{"revert depth", 10, false, []Revision{rev(1, false, 0), rev(2, false, 0), rev(3, false, 1)}, []edit{{1, false}, {2, true}, {3, false}}},
+		{"revert at window end", 2, false, []Revision{rev(1, false, 0), rev(2, false, 0), rev(3, false, 2)}, []edit{{1, true}, {2, true}, {3, false}}},
+		{"revert past window end", 2, false, []Revision{rev(1, false, 0), rev(2, false, 0), rev(4, false, 0), rev(3, false, 3)}, []edit{{1, false}, {2, true}, {4, true}, {3, false}}},
+		{"anonymous", 10, false, []Revision{rev(wikibrief.AnonimousUserID, false, 0), rev(2, false, 1)}, []edit{{2, false}}},
+		{"bots excluded", 10, false, []Revision{rev(1, true, 0), rev(2, false, 1)}, []edit{{2, false}}},
+		{"bots included", 10, true, []Revision{rev(1, true, 0), rev(2, false, 1)}, []edit{{1, true}, {2, false}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newNewcomerDetector(tt.window, tt.includeBots)
+			var got []edit
+			for _, r := range tt.revisions {
+				for _, e := range d.Add(r) {
+					got = append(got, edit{e.UserID, e.Reverted})
+				}
+			}
+			for _, e := range d.Flush() {
+				got = append(got, edit{e.UserID, e.Reverted})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
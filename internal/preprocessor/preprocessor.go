@@ -10,64 +10,132 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 
-	"github.com/ebonetti/ctxutils"
+	"github.com/negapedia/negapedia/internal/failures"
 	"github.com/negapedia/wikiassignment"
 	"github.com/negapedia/wikiassignment/nationalization"
 )
 
 type Process func(ctx context.Context, fail func(error) error, articles <-chan wikibrief.EvolvingPage)
 
-func Run(ctx context.Context, CSVDir, lang string, test bool, processors ...Process) (err error) {
-	ctx, fail := ctxutils.WithFail(ctx)
+//Source produces the stream of articles to preprocess, it may use tmpDir for its temporary files.
+type Source func(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage
+
+//NetSource downloads and parses the Wikipedia dumps of lang.
+func NetSource(ctx context.Context, fail func(error) error, tmpDir, lang string, test bool) <-chan wikibrief.EvolvingPage {
+	article2Topic, namespaces, err := wikiassignment.From(ctx, tmpDir, lang)
+	if err != nil {
+		fail(err)
+		articles := make(chan wikibrief.EvolvingPage)
+		close(articles)
+		return articles
+	}
+
+	//Filter out non articles
+	articlesIDS := roaring.BitmapOf(namespaces.Articles...)
+	for pageID := range article2Topic {
+		if !articlesIDS.Contains(pageID) {
+			delete(article2Topic, pageID)
+		}
+	}
+
+	return wikibrief.New(ctx, fail, tmpDir, lang, test)
+}
+
+//Run feeds the articles of source to the registered processes with the given names, then it checks their outputs.
+func Run(ctx context.Context, CSVDir, lang string, test bool, settings Settings, source Source, processes ...string) (err error) {
+	ctx, collector := failures.WithCollector(ctx)
+	fail := collector.Fail("preprocessor")
 	defer func() {
 		if fe := fail(err); fe != nil {
 			err = fe
 		}
 	}()
 
-	tmpDir, err := ioutil.TempDir(CSVDir, ".")
+	definitions, err := lookup(processes)
 	if err != nil {
 		return
 	}
-	defer os.RemoveAll(tmpDir)
 
-	article2Topic, namespaces, err := wikiassignment.From(ctx, tmpDir, lang)
+	tmpDir, err := ioutil.TempDir(CSVDir, ".")
 	if err != nil {
 		return
 	}
-
-	//Filter out non articles
-	articlesIDS := roaring.BitmapOf(namespaces.Articles...)
-	for pageID := range article2Topic {
-		if !articlesIDS.Contains(pageID) {
-			delete(article2Topic, pageID)
-		}
-	}
+	defer os.RemoveAll(tmpDir)
 
 	nationalization, err := nationalization.New(lang)
 	if err != nil {
 		return
 	}
 
-	processors = append([]Process{preprocessor{nationalization, CSVDir, tmpDir, fail}.exportCSV}, processors...)
+	env := Environment{nationalization, lang, CSVDir, tmpDir, test, settings, collector}
+	var processors []func(articlesCh <-chan wikibrief.EvolvingPage, measuredCh <-chan Article)
+	var measuredCount int
+	for _, d := range definitions {
+		fail := collector.Fail(d.Name)
+		if d.New != nil {
+			p, err := d.New(env)
+			if err != nil {
+				return err
+			}
+			processors = append(processors, func(articlesCh <-chan wikibrief.EvolvingPage, _ <-chan Article) { p(ctx, fail, articlesCh) })
+			continue
+		}
+		p, err := d.NewMeasured(env)
+		if err != nil {
+			return err
+		}
+		processors = append(processors, func(_ <-chan wikibrief.EvolvingPage, measuredCh <-chan Article) { p(ctx, fail, measuredCh) })
+		measuredCount++
+	}
 
-	articlesChs := wikibrief.FanOut(ctx, wikibrief.New(ctx, fail, tmpDir, lang, test), len(processors))
+	//Measured processes share a single stream, whose revision texts are dropped as soon as possible:
+	//before fanning out, unless other processes need the texts
+	articles := source(ctx, collector.Fail("source"), tmpDir, lang, test)
+	var articlesChs []<-chan wikibrief.EvolvingPage
+	var measuredChs []<-chan Article
+	switch fullCount := len(processors) - measuredCount; {
+	case measuredCount == 0:
+		articlesChs = wikibrief.FanOut(ctx, articles, fullCount)
+	case fullCount == 0:
+		measuredChs = fanOutArticles(ctx, measure(ctx, articles), measuredCount)
+	default:
+		articlesChs = wikibrief.FanOut(ctx, articles, fullCount+1)
+		measuredChs = fanOutArticles(ctx, measure(ctx, articlesChs[fullCount]), measuredCount)
+		articlesChs = articlesChs[:fullCount]
+	}
 
 	var wg sync.WaitGroup
 	for i, p := range processors {
 		wg.Add(1)
-		go func(i int, p Process) {
+		var articlesCh <-chan wikibrief.EvolvingPage
+		var measuredCh <-chan Article
+		if definitions[i].New != nil {
+			articlesCh, articlesChs = articlesChs[0], articlesChs[1:]
+		} else {
+			measuredCh, measuredChs = measuredChs[0], measuredChs[1:]
+		}
+		go func(p func(<-chan wikibrief.EvolvingPage, <-chan Article)) {
 			defer wg.Done()
-			p(ctx, fail, articlesChs[i])
-		}(i, p)
+			p(articlesCh, measuredCh)
+		}(p)
 	}
 	wg.Wait()
 
-	return
+	if ctx.Err() != nil {
+		return
+	}
+	for _, d := range definitions {
+		if err = d.checkOutputs(env); err != nil {
+			return
+		}
+	}
+
+	return settings.write(CSVDir)
 }
 
 type preprocessor struct {
 	nationalization.Nationalization
 	CSVDir, TmpDir string
-	Fail           func(error) error
+	Settings       Settings
+	Failures       *failures.Collector
 }
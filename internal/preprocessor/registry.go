@@ -0,0 +1,204 @@
+package preprocessor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/negapedia/negapedia/internal/failures"
+	"github.com/negapedia/wikiassignment/nationalization"
+	"github.com/pkg/errors"
+)
+
+//Environment is the environment of a run, from which processes are created.
+type Environment struct {
+	Nationalization      nationalization.Nationalization
+	Lang, CSVDir, TmpDir string
+	Test                 bool
+	Settings             Settings
+	Failures             *failures.Collector
+}
+
+//Settings tunes how processes interpret the revisions.
+type Settings struct {
+	//EditWarWindow is the maximum time between two users reverting each other for their reverts to be part of an edit war.
+	EditWarWindow time.Duration
+	//NewcomerRevertWindow is the number of following revisions within which the first edit of a user to an article counts as reverted.
+	NewcomerRevertWindow int
+	//IncludeBots makes bots count as any other user in social jumps, edit wars and newcomers.
+	IncludeBots bool
+}
+
+//DefaultSettings are the settings of a run, unless otherwise specified.
+var DefaultSettings = Settings{EditWarWindow: 48 * time.Hour, NewcomerRevertWindow: 10}
+
+//settingsFile records in CSVDir the settings of the run that produced the savepoints.
+const settingsFile = "settings.json"
+
+func (s Settings) write(CSVDir string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(ioutil.WriteFile(filepath.Join(CSVDir, settingsFile), b, 0644))
+}
+
+func readSettings(CSVDir string) (s Settings, err error) {
+	b, err := ioutil.ReadFile(filepath.Join(CSVDir, settingsFile))
+	if err != nil {
+		return s, errors.WithStack(err)
+	}
+	return s, errors.WithStack(json.Unmarshal(b, &s))
+}
+
+//Output is a file or folder produced by a process.
+type Output struct {
+	Path string
+	//Savepoint outputs are reused by later runs with source savepoint.
+	Savepoint bool
+}
+
+//Definition describes a named process.
+type Definition struct {
+	Name string
+	//Outputs returns what the process produces in env.
+	Outputs func(env Environment) []Output
+	//New returns the process to run in env, NewMeasured is the alternative for processes that need only the length of revision texts.
+	New         func(env Environment) (Process, error)
+	NewMeasured func(env Environment) (MeasuredProcess, error)
+}
+
+var registry = struct {
+	sync.Mutex
+	definitions map[string]Definition
+}{definitions: map[string]Definition{}}
+
+//Register makes a process available by its name, it panics if the name is already taken.
+func Register(d Definition) {
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.definitions[d.Name]; ok {
+		panic("preprocessor: process " + d.Name + " registered twice")
+	}
+	registry.definitions[d.Name] = d
+}
+
+//Registered returns the names of the registered processes.
+func Registered() (names []string) {
+	registry.Lock()
+	defer registry.Unlock()
+	for name := range registry.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
+
+func lookup(names []string) (definitions []Definition, err error) {
+	registry.Lock()
+	defer registry.Unlock()
+	seen := map[string]bool{}
+	for _, name := range names {
+		d, ok := registry.definitions[name]
+		switch {
+		case !ok:
+			return nil, errors.New("error: process " + name + " not registered")
+		case (d.New == nil) == (d.NewMeasured == nil):
+			return nil, errors.New("error: process " + name + " must define exactly one between New and NewMeasured")
+		case seen[name]:
+			continue
+		}
+		seen[name] = true
+		definitions = append(definitions, d)
+	}
+	if len(definitions) == 0 {
+		return nil, errors.New("error: no process selected")
+	}
+	return
+}
+
+//CheckSavepoint returns an error if an output of the processes with the given names, reused by source savepoint, is missing from CSVDir
+//or if it was produced with settings other than the given ones.
+func CheckSavepoint(CSVDir string, settings Settings, processes ...string) error {
+	definitions, err := lookup(processes)
+	if err != nil {
+		return err
+	}
+	saved, err := readSettings(CSVDir)
+	if err != nil {
+		return errors.Wrap(err, "Savepoint lacks its settings, it may come from an older version: preprocess again from another source")
+	}
+	if saved != settings {
+		return errors.Errorf("error: savepoint was preprocessed with settings %+v instead of %+v: use the same bots, editwarwindow and newcomerwindow options or preprocess again", saved, settings)
+	}
+	for _, d := range definitions {
+		for _, o := range d.outputs(Environment{CSVDir: CSVDir}) {
+			if _, err := os.Stat(o.Path); o.Savepoint && err != nil {
+				return errors.Wrapf(err, "Savepoint lacks %v of process %v, it may come from an older version: preprocess again from another source", o.Path, d.Name)
+			}
+		}
+	}
+	return nil
+}
+
+//NeedsTexts returns whether a process with the given names needs revision texts, or an error if a name is not registered.
+func NeedsTexts(processes ...string) (bool, error) {
+	definitions, err := lookup(processes)
+	if err != nil {
+		return false, err
+	}
+	for _, d := range definitions {
+		if d.New != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+//Outputs returns the outputs in CSVDir of the processes with the given names, or an error if a name is not registered.
+func Outputs(CSVDir string, processes ...string) (outputs []Output, err error) {
+	definitions, err := lookup(processes)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range definitions {
+		outputs = append(outputs, d.outputs(Environment{CSVDir: CSVDir})...)
+	}
+	return
+}
+
+func (d Definition) outputs(env Environment) []Output {
+	if d.Outputs == nil {
+		return nil
+	}
+	return d.Outputs(env)
+}
+
+//checkOutputs returns an error if an output of d is missing.
+func (d Definition) checkOutputs(env Environment) error {
+	for _, o := range d.outputs(env) {
+		if _, err := os.Stat(o.Path); err != nil {
+			return errors.Wrapf(err, "Process %v did not produce %v", d.Name, o.Path)
+		}
+	}
+	return nil
+}
+
+func init() {
+	Register(Definition{
+		Name: "csv",
+		Outputs: func(env Environment) (outputs []Output) {
+			for _, name := range []string{"pages", "revisions", "socialjumps", "editwars", "newcomers"} {
+				outputs = append(outputs, Output{filepath.Join(env.CSVDir, name+".csv"), true})
+			}
+			return
+		},
+		NewMeasured: func(env Environment) (MeasuredProcess, error) {
+			return preprocessor{env.Nationalization, env.CSVDir, env.TmpDir, env.Settings, env.Failures}.exportCSV, nil
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package preprocessor
+
+//revertMarker marks, in the revisions of an article, the ones that get reverted: a revert reverts the IsRevert previous revisions,
+//up to _EditWarHistory as in editWarDetector.
+type revertMarker struct {
+	pending []*csvRevision //revisions that may still get reverted, ordered by ID
+}
+
+//Add processes the next revision, returning the oldest pending one if it can no longer be reverted.
+func (m *revertMarker) Add(r *csvRevision) (done *csvRevision) {
+	n := int(r.IsRevert)
+	if n > len(m.pending) {
+		n = len(m.pending)
+	}
+	for _, reverted := range m.pending[len(m.pending)-n:] {
+		reverted.IsReverted = true
+	}
+
+	m.pending = append(m.pending, r)
+	if len(m.pending) > _EditWarHistory {
+		done, m.pending = m.pending[0], m.pending[1:]
+	}
+	return
+}
+
+//Flush returns the pending revisions at the end of the article.
+func (m *revertMarker) Flush() (done []*csvRevision) {
+	done, m.pending = m.pending, nil
+	return
+}
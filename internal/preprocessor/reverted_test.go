@@ -0,0 +1,51 @@
+package preprocessor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRevertMarker(t *testing.T) {
+	long := make([]uint32, _EditWarHistory+2)
+	long[len(long)-1] = _EditWarHistory + 1
+	wantLong := make([]bool, len(long))
+	for i := 1; i < len(long)-1; i++ {
+		wantLong[i] = true
+	}
+	tests := []struct {
+		name     string
+		isRevert []uint32 //of each revision
+		want     []bool   //isReverted of each revision
+	}{
+		{"none", nil, nil},
+		{"no reverts", []uint32{0, 0}, []bool{false, false}},
+		{"revert", []uint32{0, 0, 1}, []bool{false, true, false}},
+		{"revert depth", []uint32{0, 0, 0, 2}, []bool{false, true, true, false}},
+		{"revert beyond the first revision", []uint32{0, 3}, []bool{true, false}},
+		{"reverted revert", []uint32{0, 1, 1}, []bool{true, true, false}},
+		{"revert beyond history", long, wantLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m revertMarker
+			var done []*csvRevision
+			for i, isRevert := range tt.isRevert {
+				if r := m.Add(&csvRevision{ID: uint32(i + 1), IsRevert: isRevert}); r != nil {
+					done = append(done, r)
+				}
+			}
+			done = append(done, m.Flush()...)
+
+			var got []bool
+			for i, r := range done {
+				if r.ID != uint32(i+1) {
+					t.Fatalf("revision %v returned in position %v", r.ID, i+1)
+				}
+				got = append(got, r.IsReverted)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package preprocessor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+//_UsersSpillThreshold is the number of users of an article above which their aggregation spills to disk.
+var _UsersSpillThreshold = 1 << 14
+
+//userWeights aggregates the weights of the users of an article, used for social jumps calculations.
+//Above _UsersSpillThreshold users the contributions are spilled to disk and aggregated by sort, so that memory stays bounded.
+type userWeights struct {
+	weights map[uint32]float64
+	tmpDir  string
+	spill   *os.File
+	w       *bufio.Writer
+	seq     uint64
+}
+
+func newUserWeights(tmpDir string) *userWeights {
+	return &userWeights{weights: map[uint32]float64{}, tmpDir: tmpDir}
+}
+
+//Contribution kinds, as written on disk
+const (
+	setContribution = iota
+	revertContribution
+	diffContribution
+)
+
+//nextWeight returns the weight of a user after a contribution.
+func nextWeight(weight float64, isRevert bool, diff float64) float64 {
+	switch {
+	case isRevert:
+		return math.Max(weight, 1.0)
+	case diff <= 100.0: //&& isPositive
+		return math.Max(weight, 10.0)
+	case weight <= 10:
+		weight = 0 //Resetting weight for different scheme.
+	}
+	return math.Min(weight+diff/10, 100)
+}
+
+//Add records a contribution of userID.
+func (u *userWeights) Add(userID uint32, isRevert bool, diff float64) (err error) {
+	if u.spill == nil {
+		u.weights[userID] = nextWeight(u.weights[userID], isRevert, diff)
+		if len(u.weights) <= _UsersSpillThreshold {
+			return nil
+		}
+		return u.startSpill()
+	}
+
+	kind := diffContribution
+	if isRevert {
+		kind = revertContribution
+	}
+	return u.write(userID, kind, diff)
+}
+
+func (u *userWeights) startSpill() (err error) {
+	if u.spill, err = ioutil.TempFile(u.tmpDir, "users"); err != nil {
+		return errors.Wrap(err, "Error while creating users spill file")
+	}
+	u.w = bufio.NewWriter(u.spill)
+	for userID, weight := range u.weights {
+		if err = u.write(userID, setContribution, weight); err != nil {
+			return
+		}
+	}
+	u.weights = nil
+	return
+}
+
+func (u *userWeights) write(userID uint32, kind int, value float64) error {
+	u.seq++
+	_, err := fmt.Fprintln(u.w, userID, u.seq, kind, value)
+	return errors.Wrap(err, "Error while writing users spill file")
+}
+
+//Close ends the contributions, so that no file stays open while waiting for Range.
+func (u *userWeights) Close() (err error) {
+	if u.spill == nil {
+		return nil
+	}
+	if err = u.w.Flush(); err != nil {
+		u.spill.Close()
+		return errors.Wrap(err, "Error while writing users spill file")
+	}
+	return errors.Wrap(u.spill.Close(), "Error while closing users spill file")
+}
+
+//Range calls f for each user and its weight, it must be called at most once, after Close.
+func (u *userWeights) Range(ctx context.Context, f func(userID uint32, weight float64) error) (err error) {
+	if u.spill == nil {
+		for userID, weight := range u.weights {
+			if err = f(userID, weight); err != nil {
+				return
+			}
+		}
+		return
+	}
+	defer os.Remove(u.spill.Name())
+
+	cmd := exec.CommandContext(ctx, "sort", "-n", "-k", "1,1", "-k", "2,2", "-S", "10%", "-T", u.tmpDir, u.spill.Name())
+	var cmdStderr bytes.Buffer
+	cmd.Stderr = &cmdStderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "Error opening sort pipe")
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.Wrap(err, "Error while starting sort")
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, stdout) //let sort end
+		if err1 := cmd.Wait(); err == nil && err1 != nil {
+			err = errors.Wrap(err1, "Error while waiting for sort end, with the following error stream:\n"+cmdStderr.String())
+		}
+	}()
+
+	r := bufio.NewReader(stdout)
+	current, weight := uint32(0), 0.0
+	for first := true; ; first = false {
+		var userID uint32
+		var seq uint64
+		var kind int
+		var value float64
+		_, err = fmt.Fscanln(r, &userID, &seq, &kind, &value)
+		switch {
+		case err == io.EOF:
+			err = nil
+			if !first {
+				err = f(current, weight)
+			}
+			return
+		case err != nil:
+			return errors.Wrap(err, "Error while fetching next user contribution after sort, with the following error stream:\n"+cmdStderr.String())
+		case !first && userID != current:
+			if err = f(current, weight); err != nil {
+				return
+			}
+			weight = 0
+		}
+
+		current = userID
+		switch kind {
+		case setContribution:
+			weight = value
+		default:
+			weight = nextWeight(weight, kind == revertContribution, value)
+		}
+	}
+}
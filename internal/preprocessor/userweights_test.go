@@ -0,0 +1,67 @@
+package preprocessor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestUserWeights(t *testing.T) {
+	type contribution struct {
+		UserID   uint32
+		IsRevert bool
+		Diff     float64
+	}
+	//many contributions of few users, so that spilled sequence numbers have several digits
+	var long []contribution
+	for i := 0; i < 120; i++ {
+		long = append(long, contribution{uint32(i % 3), i%7 == 0, float64(i*37%500 - 100)})
+	}
+	contributions := map[string][]contribution{
+		"none":    nil,
+		"one":     {{1, false, 50}},
+		"revert":  {{1, true, 0}, {2, false, 200}, {1, false, 1000}},
+		"reset":   {{1, false, 50}, {1, false, 300}, {1, false, -50}},
+		"mixed":   {{5, false, 150}, {3, true, 0}, {5, false, 2000}, {4, false, 10}, {3, false, 120}, {1, false, 400}, {5, true, 0}, {4, false, 110}},
+		"long":    long,
+		"big IDs": {{4294967295, false, 500}, {1, false, 500}, {4294967295, false, 500}},
+	}
+	for _, threshold := range []int{1 << 14, 0, 1, 2} {
+		for name, cc := range contributions {
+			t.Run(name, func(t *testing.T) {
+				defer func(old int) { _UsersSpillThreshold = old }(_UsersSpillThreshold)
+				_UsersSpillThreshold = threshold
+
+				want := map[uint32]float64{}
+				u := newUserWeights(t.TempDir())
+				for _, c := range cc {
+					want[c.UserID] = nextWeight(want[c.UserID], c.IsRevert, c.Diff)
+					if err := u.Add(c.UserID, c.IsRevert, c.Diff); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := u.Close(); err != nil {
+					t.Fatal(err)
+				}
+
+				got := map[uint32]float64{}
+				err := u.Range(context.Background(), func(userID uint32, weight float64) error {
+					if _, ok := got[userID]; ok {
+						t.Errorf("user %v ranged twice with threshold %v", userID, threshold)
+					}
+					got[userID] = weight
+					return nil
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if spilled := u.spill != nil; spilled != (len(want) > threshold) {
+					t.Errorf("spilled is %v with %v users and threshold %v", spilled, len(want), threshold)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("got %v, want %v with threshold %v", got, want, threshold)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,180 @@
+//Package synthetic generates a reproducible wiki, for offline end-to-end runs and load testing.
+package synthetic
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/negapedia/wikiassignment/nationalization"
+	"github.com/negapedia/wikibrief"
+	"github.com/pkg/errors"
+)
+
+//Config describes the synthetic wiki.
+type Config struct {
+	Articles, Users, Bots int
+	//AnonymousRate, BotRate and RevertRate are the probabilities that a revision is anonymous, by a bot or a revert
+	AnonymousRate, BotRate, RevertRate float64
+	//MeanRevisions is the mean number of revisions of an article
+	MeanRevisions       int
+	FirstYear, LastYear int
+	Seed                int64
+}
+
+//DefaultConfig returns a reasonable configuration for a wiki of the given number of articles.
+func DefaultConfig(articles int, seed int64) Config {
+	return Config{
+		Articles:      articles,
+		Users:         articles/4 + 1,
+		Bots:          articles/1000 + 1,
+		AnonymousRate: 0.1,
+		BotRate:       0.05,
+		RevertRate:    0.05,
+		MeanRevisions: 30,
+		FirstYear:     2001,
+		LastYear:      2020,
+		Seed:          seed,
+	}
+}
+
+//New returns the stream of the articles of the synthetic wiki, assigned to the topics of the nationalization lang.
+//Articles, users and revisions depend only on lang and c.
+func New(ctx context.Context, fail func(error) error, lang string, c Config) <-chan wikibrief.EvolvingPage {
+	out := make(chan wikibrief.EvolvingPage, 100)
+	go func() {
+		defer close(out)
+		n, err := nationalization.New(lang)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if err = c.check(n); err != nil {
+			fail(err)
+			return
+		}
+
+		g := &generator{Config: c, rand: rand.New(rand.NewSource(c.Seed)), topics: n.Topics}
+		firstID := uint32(1) //article IDs follow topic IDs, so that they never collide
+		for _, t := range n.Topics {
+			if t.ID >= firstID {
+				firstID = t.ID + 1
+			}
+		}
+
+		for i := 0; i < c.Articles; i++ {
+			select {
+			case out <- g.article(firstID + uint32(i)):
+				//proceed
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (c Config) check(n nationalization.Nationalization) error {
+	switch {
+	case len(n.Topics) == 0:
+		return errors.New("Synthetic wiki: nationalization " + n.Language + " has no topics")
+	case c.Articles < 0 || c.Users < 1 || c.Bots < 0 || c.MeanRevisions < 1:
+		return errors.Errorf("Synthetic wiki: invalid size in %+v", c)
+	case c.FirstYear > c.LastYear:
+		return errors.Errorf("Synthetic wiki: invalid years in %+v", c)
+	}
+	return nil
+}
+
+type generator struct {
+	Config
+	rand       *rand.Rand
+	topics     []nationalization.Page
+	revisionID uint32
+}
+
+func (g *generator) article(ID uint32) wikibrief.EvolvingPage {
+	topic := g.topics[g.rand.Intn(len(g.topics))]
+	title := fmt.Sprintf("%s %s %d", g.word(), g.word(), ID)
+	nRevisions := 1 + int(g.rand.ExpFloat64()*float64(g.MeanRevisions-1))
+
+	//Revisions are generated in advance, the article is sent only when its revisions are ready to be consumed
+	revisions := make(chan wikibrief.Revision, nRevisions)
+	defer close(revisions)
+
+	creation := g.timestamp(time.Date(g.FirstYear, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := time.Date(g.LastYear+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	step := end.Sub(creation) / time.Duration(nRevisions)
+
+	texts := []string{}
+	timestamp := creation
+	for i := 0; i < nRevisions; i++ {
+		g.revisionID++
+		r := wikibrief.Revision{ID: g.revisionID, Timestamp: timestamp}
+		switch p := g.rand.Float64(); {
+		case p < g.AnonymousRate:
+			r.UserID = wikibrief.AnonimousUserID
+		case g.Bots > 0 && p < g.AnonymousRate+g.BotRate:
+			r.UserID, r.IsBot = uint32(g.Users+1+g.rand.Intn(g.Bots)), true
+		default:
+			r.UserID = 1 + uint32(g.rand.Intn(g.Users))
+		}
+
+		switch {
+		case len(texts) > 1 && g.rand.Float64() < g.RevertRate: //revert to a previous version
+			reverted := 1 + g.rand.Intn(len(texts)-1)
+			r.Text, r.IsRevert = texts[len(texts)-1-reverted], uint32(reverted)
+		case len(texts) > 0 && g.rand.Intn(4) == 0: //shrink
+			text := texts[len(texts)-1]
+			r.Text = text[:g.rand.Intn(len(text)+1)]
+		default: //grow
+			r.Text = g.paragraph(texts)
+		}
+		sha := sha1.Sum([]byte(r.Text))
+		r.SHA1 = hex.EncodeToString(sha[:])
+
+		texts = append(texts, r.Text)
+		revisions <- r
+		timestamp = timestamp.Add(time.Duration(g.rand.Int63n(int64(2*step) + 1)))
+		if timestamp.After(end) {
+			timestamp = end.Add(-time.Second)
+		}
+	}
+
+	return wikibrief.EvolvingPage{PageID: ID, Title: title, Abstract: g.sentence(), TopicID: topic.ID, Revisions: revisions}
+}
+
+//timestamp returns a random time between from and the end of the last year.
+func (g *generator) timestamp(from time.Time) time.Time {
+	end := time.Date(g.LastYear+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	return from.Add(time.Duration(g.rand.Int63n(int64(end.Sub(from)))))
+}
+
+func (g *generator) paragraph(texts []string) string {
+	text := ""
+	if len(texts) > 0 {
+		text = texts[len(texts)-1] + " "
+	}
+	return text + g.sentence()
+}
+
+func (g *generator) sentence() string {
+	words := make([]string, 5+g.rand.Intn(20))
+	for i := range words {
+		words[i] = g.word()
+	}
+	return strings.Join(words, " ") + "."
+}
+
+var vocabulary = strings.Fields(`history war science art music politics city river mountain king queen church
+	university football film novel language species island empire battle election party painter poet
+	theory planet computer company railway bridge castle festival album village province dynasty`)
+
+//word picks a word with a Zipf-like distribution, so that TFIDF has something to weigh.
+func (g *generator) word() string {
+	return vocabulary[int(float64(len(vocabulary))*g.rand.Float64()*g.rand.Float64())]
+}
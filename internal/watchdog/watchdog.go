@@ -0,0 +1,196 @@
+//Package watchdog detects stalled pipelines by tracking the progress counters of their stages.
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//Watchdog tracks the progress of the stages of a run.
+type Watchdog struct {
+	mutex  sync.Mutex
+	stages map[string]*Counter
+}
+
+//Counter tracks the progress of a stage, its methods are safe for concurrent use and are no-op on nil.
+type Counter struct {
+	count, active int64
+}
+
+//Inc records a step of progress.
+func (c *Counter) Inc() {
+	if c != nil {
+		atomic.AddInt64(&c.count, 1)
+	}
+}
+
+//Done marks the end of a stage activity, began with Progress.
+func (c *Counter) Done() {
+	if c != nil {
+		atomic.AddInt64(&c.active, -1)
+	}
+}
+
+type watchdogKey struct{}
+
+//New returns a new Watchdog and a copy of ctx carrying it.
+func New(ctx context.Context) (context.Context, *Watchdog) {
+	w := &Watchdog{stages: map[string]*Counter{}}
+	return context.WithValue(ctx, watchdogKey{}, w), w
+}
+
+//Progress returns the counter of stage from the Watchdog of ctx, marking the stage as active until Done is called.
+//If ctx has no Watchdog it returns nil, which is a valid no-op Counter.
+func Progress(ctx context.Context, stage string) *Counter {
+	w, _ := ctx.Value(watchdogKey{}).(*Watchdog)
+	if w == nil {
+		return nil
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	c, ok := w.stages[stage]
+	if !ok {
+		c = &Counter{}
+		w.stages[stage] = c
+	}
+	atomic.AddInt64(&c.active, 1)
+	return c
+}
+
+//StageState is a snapshot of the counter of a stage.
+type StageState struct {
+	Stage         string
+	Count, Active int64
+}
+
+//States returns a snapshot of the counters of w, sorted by stage; on nil it returns nil.
+func (w *Watchdog) States() (states []StageState) {
+	if w == nil {
+		return nil
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for stage, c := range w.stages {
+		states = append(states, StageState{stage, atomic.LoadInt64(&c.count), atomic.LoadInt64(&c.active)})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Stage < states[j].Stage })
+	return
+}
+
+func (w *Watchdog) state() (states []StageState, total int64, active bool) {
+	states = w.States()
+	for _, s := range states {
+		total += s.Count
+		active = active || s.Active > 0
+	}
+	return
+}
+
+//MinTimeout is the minimum sensible timeout of Watch, below it progress is checked every MinTimeout/4.
+const MinTimeout = time.Second
+
+var (
+	_MinTick            = MinTimeout / 4   //the shortest period between progress checks
+	_CPUProfileDuration = 30 * time.Second //the longest CPU profile of diagnostics
+)
+
+//Watch checks, until ctx is done, that some counter moves at least once every timeout while any stage is active.
+//Otherwise it dumps goroutine stacks, heap and CPU profiles in a new folder inside dir and,
+//if failOnStall is true, it fails with an error.
+func (w *Watchdog) Watch(ctx context.Context, fail func(error) error, timeout time.Duration, dir string, failOnStall bool) {
+	go func() {
+		tick := timeout / 4
+		if tick < _MinTick {
+			tick = _MinTick
+		}
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+
+		_, oldTotal, _ := w.state()
+		lastMove := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				//Go on
+			}
+
+			states, total, active := w.state()
+			switch {
+			case total != oldTotal || !active:
+				oldTotal, lastMove = total, time.Now()
+			case time.Since(lastMove) >= timeout:
+				diagnosticsDir, err := diagnostics(dir, states, timeout)
+				lastMove = time.Now() //Avoid dumping continuously the same stall, diagnostics take up to timeout
+				stall := errors.Errorf("Stall: no progress in %v, diagnostics in %v", timeout, diagnosticsDir)
+				switch {
+				case err != nil:
+					fail(errors.Wrap(err, stall.Error()))
+				case failOnStall:
+					fail(stall)
+				default:
+					log.Print(stall)
+				}
+			}
+		}
+	}()
+}
+
+func diagnostics(dir string, states []StageState, timeout time.Duration) (diagnosticsDir string, err error) {
+	diagnosticsDir = filepath.Join(dir, fmt.Sprint("stall ", time.Now().Format("2006-01-02 15:04:05")))
+	if err = os.MkdirAll(diagnosticsDir, os.ModePerm); err != nil {
+		return diagnosticsDir, errors.Wrap(err, "Error while creating diagnostics folder")
+	}
+
+	write := func(filename string, dump func(f io.Writer) error) {
+		f, e := os.Create(filepath.Join(diagnosticsDir, filename))
+		if e == nil {
+			e = dump(f)
+			if ce := f.Close(); e == nil {
+				e = ce
+			}
+		}
+		if e != nil && err == nil {
+			err = errors.Wrap(e, "Error while writing "+filename)
+		}
+	}
+
+	write("progress.txt", func(f io.Writer) (err error) {
+		for _, s := range states {
+			if _, err = fmt.Fprintf(f, "%s: count %d, active %d\n", s.Stage, s.Count, s.Active); err != nil {
+				return
+			}
+		}
+		return
+	})
+	write("goroutines.txt", func(f io.Writer) error { return pprof.Lookup("goroutine").WriteTo(f, 2) })
+	write("heap.pprof", pprof.WriteHeapProfile)
+	write("cpu.pprof", func(f io.Writer) error {
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return nil //CPU profiling is already active elsewhere
+		}
+		d := _CPUProfileDuration
+		if timeout < d {
+			d = timeout
+		}
+		time.Sleep(d)
+		pprof.StopCPUProfile()
+		return nil
+	})
+
+	return
+}
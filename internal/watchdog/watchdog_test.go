@@ -0,0 +1,82 @@
+package watchdog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	t.Cleanup(func(minTick, cpuProfileDuration time.Duration) func() {
+		return func() { _MinTick, _CPUProfileDuration = minTick, cpuProfileDuration }
+	}(_MinTick, _CPUProfileDuration))
+	const timeout = 20 * time.Millisecond
+	_MinTick, _CPUProfileDuration = timeout/4, time.Millisecond
+
+	tests := []struct {
+		name      string
+		timeout   time.Duration
+		progress  func(ctx context.Context, c *Counter) //runs while watched
+		wantStall bool
+	}{
+		{"stalled", timeout, func(ctx context.Context, c *Counter) {}, true},
+		{"progressing", timeout, func(ctx context.Context, c *Counter) {
+			for ticker := time.NewTicker(timeout / 10); ; {
+				select {
+				case <-ctx.Done():
+					ticker.Stop()
+					return
+				case <-ticker.C:
+					c.Inc()
+				}
+			}
+		}, false},
+		{"inactive", timeout, func(ctx context.Context, c *Counter) { c.Done() }, false},
+		{"timeout below minimum", time.Nanosecond, func(ctx context.Context, c *Counter) { c.Done() }, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 3*timeout)
+			defer cancel()
+			ctx, w := New(ctx)
+			dir := t.TempDir()
+
+			stalls := make(chan error, 10)
+			w.Watch(ctx, func(err error) error { stalls <- err; return err }, tt.timeout, dir, true)
+			go tt.progress(ctx, Progress(ctx, "stage"))
+
+			var stall error
+			select {
+			case stall = <-stalls:
+			case <-ctx.Done():
+			}
+			cancel()
+			if (stall != nil) != tt.wantStall {
+				t.Fatalf("got stall %v, want stall %v", stall, tt.wantStall)
+			}
+			if !tt.wantStall {
+				return
+			}
+			folders, err := filepath.Glob(filepath.Join(dir, "stall *", "progress.txt"))
+			if err != nil || len(folders) != 1 {
+				t.Fatalf("got diagnostics %v, error %v", folders, err)
+			}
+			if b, err := os.ReadFile(folders[0]); err != nil || string(b) != "stage: count 0, active 1\n" {
+				t.Errorf("got progress %q, error %v", b, err)
+			}
+		})
+	}
+}
+
+func TestProgressWithoutWatchdog(t *testing.T) {
+	c := Progress(context.Background(), "stage")
+	if c != nil {
+		t.Fatalf("got counter %v from a context without watchdog", c)
+	}
+	c.Inc() //no-op
+	c.Done()
+}